@@ -32,6 +32,12 @@ func OpCodeForEventType(eventType fgs.EventType) (reflect.Type, error) {
 		opCode = reflect.TypeOf(&fgs.GetEventsResponse_ProcessTracepoint{})
 	case fgs.EventType_PROCESS_DNS:
 		opCode = reflect.TypeOf(&fgs.GetEventsResponse_ProcessDns{})
+	case fgs.EventType_EVENTS_DROPPED:
+		opCode = reflect.TypeOf(&fgs.GetEventsResponse_EventsDropped{})
+	case fgs.EventType_LOST_EVENTS:
+		opCode = reflect.TypeOf(&fgs.GetEventsResponse_LostEvents{})
+	case fgs.EventType_RATE_LIMIT_INFO:
+		opCode = reflect.TypeOf(&fgs.GetEventsResponse_RateLimitInfo{})
 	case fgs.EventType_TEST:
 		opCode = reflect.TypeOf(&fgs.GetEventsResponse_Test{})
 	default:
@@ -39,6 +39,12 @@ func EventTypeString(event event) (string, error) {
 		return fgs.EventType_PROCESS_KPROBE.String(), nil
 	case *fgs.GetEventsResponse_ProcessTracepoint:
 		return fgs.EventType_PROCESS_TRACEPOINT.String(), nil
+	case *fgs.GetEventsResponse_EventsDropped:
+		return fgs.EventType_EVENTS_DROPPED.String(), nil
+	case *fgs.GetEventsResponse_LostEvents:
+		return fgs.EventType_LOST_EVENTS.String(), nil
+	case *fgs.GetEventsResponse_RateLimitInfo:
+		return fgs.EventType_RATE_LIMIT_INFO.String(), nil
 	case *fgs.GetEventsResponse_Test:
 		return fgs.EventType_TEST.String(), nil
 	case *fgs.GetEventsResponse_ProcessDns:
@@ -21,6 +21,8 @@ enum generic_func_args_enum {
 	argreturn = 0x31,
 	/* use return argument for buffer copy */
 	argreturncopy = 0x32,
+	/* metadata of the return copy argument */
+	argreturncopym = 0x33,
 	/* actions enabled */
 	sigkill = 0x40,
 	/* tcp sock stat sample info */
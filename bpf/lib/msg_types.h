@@ -11,6 +11,7 @@ enum msg_ops {
 	MSG_OP_EXIT = 7,
 	MSG_OP_GENERIC_KPROBE = 13,
 	MSG_OP_GENERIC_TRACEPOINT = 14,
+	MSG_OP_DATA = 15,
 
 	MSG_OP_TEST = 254,
 
@@ -32,7 +32,7 @@ generic_kprobe_event(struct pt_regs *ctx)
 	long total = 0;
 	long size = 0;
 	unsigned long retprobe_buffer, cnt = 0;
-	long ty_arg, do_copy;
+	long ty_arg, do_copy, copy_max;
 
 	e = map_lookup_elem(&process_call_heap, &zero);
 	if (!e)
@@ -46,17 +46,33 @@ generic_kprobe_event(struct pt_regs *ctx)
 
 	ty_arg = bpf_core_enum_value(fgs_args, argreturn);
 	do_copy = bpf_core_enum_value(fgs_args, argreturncopy);
+	copy_max = get_max_data(bpf_core_enum_value(fgs_args, argreturncopym));
 	if (ty_arg)
 		size += read_call_arg(ctx, e, 0, ty_arg, 0,
 				      (unsigned long)ctx->ax, 0, 0);
 	switch (do_copy) {
 	case char_buf:
-		size += __copy_char_buf(&e->args[size], retprobe_buffer,
-					ctx->ax);
+		if (copy_max)
+			size += data_event_bytes(
+				ctx, (struct data_event_desc *)&e->args[size],
+				retprobe_buffer, (long)ctx->ax > 0 ? ctx->ax : 0,
+				copy_max);
+		else
+			size += __copy_char_buf(&e->args[size],
+						retprobe_buffer, ctx->ax);
 		break;
 	case char_iovec:
-		size += __copy_char_iovec(&e->args[size], retprobe_buffer, cnt,
-					  ctx->ax);
+		if (copy_max)
+			size += data_event_iovec(
+				ctx, (struct data_event_desc *)&e->args[size],
+				retprobe_buffer, cnt,
+				(long)ctx->ax > 0 && ctx->ax < copy_max ?
+					ctx->ax :
+					copy_max);
+		else
+			size += __copy_char_iovec(&e->args[size],
+						  retprobe_buffer, cnt,
+						  ctx->ax);
 	default:
 		break;
 	}
@@ -34,6 +34,19 @@ struct bpf_map_def __attribute__((section("maps"), used)) filter_map = {
 	.max_entries = 1,
 };
 
+/* The same source is used for classic tracepoints, raw tracepoints
+ * (__RAW_TRACEPOINT), and BTF-enabled tracepoints (__BTF_TRACEPOINT). For the
+ * last two, the context is an array of u64 holding the tracepoint arguments
+ * and the argument offsets are configured accordingly from userspace.
+ */
+#if defined(__RAW_TRACEPOINT)
+#define GENERIC_TRACEPOINT_SEC "raw_tracepoint/generic_tracepoint"
+#elif defined(__BTF_TRACEPOINT)
+#define GENERIC_TRACEPOINT_SEC "tp_btf/generic_tracepoint"
+#else
+#define GENERIC_TRACEPOINT_SEC "tracepoint/generic_tracepoint"
+#endif
+
 struct generic_tracepoint_event_arg {
 	/* common header */
 	__u16 common_type;
@@ -63,6 +76,9 @@ static inline __attribute__((always_inline)) unsigned long get_ctx_ul(void *src,
 	}
 
 	case nop_s32_ty:
+	case int_type:
+	case fd_ty:
+	case capability_type:
 	case s32_ty: {
 		s32 ret;
 		probe_read(&ret, sizeof(u32), src);
@@ -76,10 +92,21 @@ static inline __attribute__((always_inline)) unsigned long get_ctx_ul(void *src,
 		return ret;
 	}
 
-	case char_buf: {
-		char *buff;
-		probe_read(&buff, sizeof(char *), src);
-		return (unsigned long)buff;
+	case char_buf:
+	case string_type:
+	case filename_ty:
+	case skb_type:
+	case sock_type:
+	case cred_type:
+	case file_ty:
+	case path_ty:
+	case bpf_attr_type:
+	case module_type:
+	case load_info_type:
+	case linux_binprm_type: {
+		void *ptr;
+		probe_read(&ptr, sizeof(void *), src);
+		return (unsigned long)ptr;
 	}
 
 	default:
@@ -88,7 +115,7 @@ static inline __attribute__((always_inline)) unsigned long get_ctx_ul(void *src,
 	}
 }
 
-__attribute__((section(("tracepoint/generic_tracepoint")), used)) int
+__attribute__((section((GENERIC_TRACEPOINT_SEC)), used)) int
 generic_tracepoint_event(struct generic_tracepoint_event_arg *ctx)
 {
 	enum generic_func_args_enum fgs_args;
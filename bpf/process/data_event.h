@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: GPL-2.0
+/* Copyright Authors of Cilium */
+
+#ifndef __DATA_EVENT_H__
+#define __DATA_EVENT_H__
+
+#include "bpf_events.h"
+
+/* Data events carry argument payloads that do not fit into the generic
+ * kprobe event. The payload is split into MSG_DATA_ARG_LEN sized chunks
+ * which are sent before the event that references them. The event itself
+ * only carries a struct data_event_desc, user space reassembles the
+ * payload using the descriptor id.
+ */
+#define MSG_DATA_ARG_LEN 8192
+
+/* Max number of chunks sent for a single argument, this bounds maxData
+ * to 64KiB and keeps the loop unrollable on older kernels.
+ */
+#define MAX_DATA_CHUNKS 8
+
+/* Max number of iovec entries sent for a single argument, each entry is
+ * sent as a single chunk.
+ */
+#define MAX_DATA_IOVEC_ENTRIES 8
+
+struct data_event_id {
+	__u64 pid;
+	__u64 time;
+} __attribute__((packed));
+
+struct data_event_desc {
+	__s32 error;
+	__u32 pad;
+	__u32 leftover;
+	__u32 size;
+	struct data_event_id id;
+} __attribute__((packed));
+
+struct msg_data {
+	struct msg_common common;
+	struct data_event_id id;
+	char arg[MSG_DATA_ARG_LEN];
+} __attribute__((packed));
+
+struct bpf_map_def __attribute__((section("maps"), used)) data_heap = {
+	.type = BPF_MAP_TYPE_PERCPU_ARRAY,
+	.key_size = sizeof(__u32),
+	.value_size = sizeof(struct msg_data),
+	.max_entries = 1,
+};
+
+/* __do_bytes: send a single chunk of at most MSG_DATA_ARG_LEN bytes read
+ * from @uptr. Returns the number of bytes sent or a negative error.
+ */
+static inline __attribute__((always_inline)) long
+__do_bytes(void *ctx, struct msg_data *msg, unsigned long uptr, size_t bytes)
+{
+	size_t total;
+	int err;
+
+	if (bytes > MSG_DATA_ARG_LEN)
+		bytes = MSG_DATA_ARG_LEN;
+	/* Code movement from clang forces us to inline bounds checks here */
+	asm volatile("%[bytes] &= 0x3fff;\n"
+		     "if %[bytes] < 0x2000 goto +1\n;"
+		     "%[bytes] = 0x2000;\n"
+		     :
+		     : [bytes] "+r"(bytes)
+		     :);
+	err = probe_read(&msg->arg[0], bytes, (char *)uptr);
+	if (err < 0)
+		return err;
+
+	total = offsetof(struct msg_data, arg) + bytes;
+	asm volatile("%[total] &= 0x3fff;\n"
+		     "if %[total] < 0x2020 goto +1\n;"
+		     "%[total] = 0x2020;\n"
+		     :
+		     : [total] "+r"(total)
+		     :);
+	msg->common.size = total;
+	err = perf_event_output(ctx, &tcpmon_map, BPF_F_CURRENT_CPU, msg,
+				total);
+	if (err < 0)
+		return err;
+	return bytes;
+}
+
+static inline __attribute__((always_inline)) long
+do_bytes(void *ctx, struct msg_data *msg, unsigned long uptr, size_t bytes)
+{
+	size_t rd_bytes = 0;
+	long err;
+	int i;
+
+#pragma unroll
+	for (i = 0; i < MAX_DATA_CHUNKS; i++) {
+		if (rd_bytes >= bytes)
+			break;
+		err = __do_bytes(ctx, msg, uptr + rd_bytes, bytes - rd_bytes);
+		if (err < 0)
+			return err;
+		rd_bytes += err;
+	}
+	return rd_bytes;
+}
+
+static inline __attribute__((always_inline)) struct msg_data *
+data_event_msg(void)
+{
+	struct msg_data *msg;
+	int zero = 0;
+
+	msg = map_lookup_elem(&data_heap, &zero);
+	if (!msg)
+		return 0;
+
+	msg->common.op = MSG_OP_DATA;
+	msg->common.flags = 0;
+	msg->common.pad[0] = 0;
+	msg->common.pad[1] = 0;
+	msg->common.ktime = ktime_get_ns();
+	msg->id.pid = get_current_pid_tgid();
+	msg->id.time = msg->common.ktime;
+	return msg;
+}
+
+static inline __attribute__((always_inline)) long
+data_event_desc_set(struct data_event_desc *desc, struct msg_data *msg,
+		    long err, size_t size)
+{
+	desc->pad = 0;
+	desc->id.pid = msg ? msg->id.pid : 0;
+	desc->id.time = msg ? msg->id.time : 0;
+	if (err < 0) {
+		desc->error = err;
+		desc->size = 0;
+		desc->leftover = size;
+	} else {
+		desc->error = 0;
+		desc->size = err;
+		desc->leftover = size > err ? size - err : 0;
+	}
+	return sizeof(*desc);
+}
+
+/* data_event_bytes: send up to @max bytes of the @size bytes buffer at
+ * @uptr as data events and store the descriptor in @desc. Returns the
+ * size of the descriptor.
+ */
+static inline __attribute__((always_inline)) long
+data_event_bytes(void *ctx, struct data_event_desc *desc, unsigned long uptr,
+		 size_t size, size_t max)
+{
+	struct msg_data *msg;
+	long err;
+
+	msg = data_event_msg();
+	if (!msg)
+		return data_event_desc_set(desc, msg, -1, size);
+
+	err = do_bytes(ctx, msg, uptr, size < max ? size : max);
+	return data_event_desc_set(desc, msg, err, size);
+}
+
+/* data_event_iovec: same as data_event_bytes but for an array of @cnt
+ * iovec entries. At most @max bytes are sent in total and entries larger
+ * than MSG_DATA_ARG_LEN are truncated.
+ */
+static inline __attribute__((always_inline)) long
+data_event_iovec(void *ctx, struct data_event_desc *desc, unsigned long arg,
+		 unsigned long cnt, size_t max)
+{
+	struct msg_data *msg;
+	size_t size = 0, total = 0;
+	long err = 0;
+	int i;
+
+	msg = data_event_msg();
+	if (!msg)
+		return data_event_desc_set(desc, msg, -1, size);
+
+#pragma unroll
+	for (i = 0; i < MAX_DATA_IOVEC_ENTRIES; i++) {
+		struct iovec iov;
+		size_t len;
+
+		if (i >= cnt || total >= max)
+			break;
+		err = probe_read(&iov, sizeof(iov), (struct iovec *)arg + i);
+		if (err < 0)
+			break;
+		size += iov.iov_len;
+		len = iov.iov_len;
+		if (len > max - total)
+			len = max - total;
+		err = __do_bytes(ctx, msg, (unsigned long)iov.iov_base, len);
+		if (err < 0)
+			break;
+		total += err;
+	}
+	if (err >= 0)
+		err = total;
+	return data_event_desc_set(desc, msg, err, size);
+}
+
+#endif // __DATA_EVENT_H__
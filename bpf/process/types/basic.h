@@ -6,6 +6,7 @@
 #include "skb.h"
 #include "sock.h"
 #include "../bpf_process_event.h"
+#include "../data_event.h"
 
 /* Type IDs form API with user space generickprobe.go */
 enum { filter = -2,
@@ -29,6 +30,12 @@ enum { filter = -2,
        file_ty = 16,
        fd_ty = 17,
 
+       bpf_attr_type = 18,
+       module_type = 19,
+       load_info_type = 20,
+       linux_binprm_type = 21,
+       capability_type = 22,
+
        nop_s64_ty = -10,
        nop_u64_ty = -11,
        nop_u32_ty = -12,
@@ -376,22 +383,128 @@ static inline __attribute__((always_inline)) long copy_sock(char *args,
 	return sizeof(struct sk_type);
 }
 
+struct msg_generic_cred {
+	struct msg_capabilities caps;
+	__u32 uid;
+	__u32 gid;
+	__u32 suid;
+	__u32 sgid;
+	__u32 euid;
+	__u32 egid;
+	__u32 fsuid;
+	__u32 fsgid;
+	__u32 securebits;
+	__u32 pad;
+};
+
 static inline __attribute__((always_inline)) long copy_cred(char *args,
 							    unsigned long arg)
 {
 	struct cred *cred = (struct cred *)arg;
-	struct msg_capabilities *caps = (struct msg_capabilities *)args;
+	struct msg_generic_cred *info = (struct msg_generic_cred *)args;
+	struct msg_capabilities *caps = &info->caps;
 
 	probe_read(&caps->effective, sizeof(__u64), _(&cred->cap_effective));
 	probe_read(&caps->inheritable, sizeof(__u64),
 		   _(&cred->cap_inheritable));
 	probe_read(&caps->permitted, sizeof(__u64), _(&cred->cap_permitted));
 
-	return sizeof(struct msg_capabilities);
+	probe_read(&info->uid, sizeof(__u32), _(&cred->uid));
+	probe_read(&info->gid, sizeof(__u32), _(&cred->gid));
+	probe_read(&info->suid, sizeof(__u32), _(&cred->suid));
+	probe_read(&info->sgid, sizeof(__u32), _(&cred->sgid));
+	probe_read(&info->euid, sizeof(__u32), _(&cred->euid));
+	probe_read(&info->egid, sizeof(__u32), _(&cred->egid));
+	probe_read(&info->fsuid, sizeof(__u32), _(&cred->fsuid));
+	probe_read(&info->fsgid, sizeof(__u32), _(&cred->fsgid));
+	probe_read(&info->securebits, sizeof(__u32), _(&cred->securebits));
+	info->pad = 0;
+
+	return sizeof(struct msg_generic_cred);
+}
+
+#ifndef BPF_OBJ_NAME_LEN
+#define BPF_OBJ_NAME_LEN 16U
+#endif
+
+struct msg_bpf_attr {
+	__u32 prog_type;
+	__u32 insn_cnt;
+	char prog_name[BPF_OBJ_NAME_LEN];
+};
+
+static inline __attribute__((always_inline)) long
+copy_bpf_attr(char *args, unsigned long arg)
+{
+	union bpf_attr *ba = (union bpf_attr *)arg;
+	struct msg_bpf_attr *bpfattr = (struct msg_bpf_attr *)args;
+
+	/* struct values */
+	probe_read(&bpfattr->prog_type, sizeof(__u32), _(&ba->prog_type));
+	probe_read(&bpfattr->insn_cnt, sizeof(__u32), _(&ba->insn_cnt));
+	probe_read(&bpfattr->prog_name, BPF_OBJ_NAME_LEN, _(&ba->prog_name));
+
+	return sizeof(struct msg_bpf_attr);
 }
 
-#define ARGM_INDEX_MASK	 ((1 << 4) - 1)
-#define ARGM_RETURN_COPY (1 << 4)
+/* Modules are reported by name, using the same layout as strings so that
+ * string filters can be used on them.
+ */
+static inline __attribute__((always_inline)) long
+copy_module(char *args, unsigned long arg)
+{
+	struct module *mod = (struct module *)arg;
+
+	return copy_strings(args, (unsigned long)_(&mod->name));
+}
+
+static inline __attribute__((always_inline)) long
+copy_load_info(char *args, unsigned long arg)
+{
+	struct load_info *info = (struct load_info *)arg;
+	const char *name = 0;
+
+	probe_read(&name, sizeof(name), _(&info->name));
+	if (!name) {
+		struct module *mod = 0;
+
+		probe_read(&mod, sizeof(mod), _(&info->mod));
+		if (!mod)
+			return filter;
+		return copy_module(args, (unsigned long)mod);
+	}
+	return copy_strings(args, (unsigned long)name);
+}
+
+/* linux_binprm is reported as two strings: the filename followed by the
+ * interpreter. The filename comes first so that string filters apply to it.
+ */
+static inline __attribute__((always_inline)) long
+copy_linux_binprm(char *args, unsigned long arg)
+{
+	struct linux_binprm *bprm = (struct linux_binprm *)arg;
+	const char *filename = 0, *interp = 0;
+	long size, isize;
+
+	probe_read(&filename, sizeof(filename), _(&bprm->filename));
+	probe_read(&interp, sizeof(interp), _(&bprm->interp));
+
+	size = copy_strings(args, (unsigned long)filename);
+	if (size < 0)
+		return size;
+	asm volatile("%[size] &= 0x7ff;\n" ::[size] "+r"(size) :);
+	isize = copy_strings(&args[size], (unsigned long)interp);
+	if (isize < 0) {
+		/* no interpreter: report an empty string */
+		*(int *)&args[size] = 0;
+		isize = 4;
+	}
+	return size + isize;
+}
+
+#define ARGM_INDEX_MASK	    ((1 << 4) - 1)
+#define ARGM_RETURN_COPY    (1 << 4)
+#define ARGM_MAX_DATA_SHIFT 8
 
 static inline __attribute__((always_inline)) bool
 hasReturnCopy(unsigned long argm)
@@ -399,6 +512,15 @@ hasReturnCopy(unsigned long argm)
 	return (argm & ARGM_RETURN_COPY) != 0;
 }
 
+/* get_max_data: returns the maxData value of the argument, if set the
+ * argument is sent as data events (see data_event.h).
+ */
+static inline __attribute__((always_inline)) unsigned long
+get_max_data(unsigned long argm)
+{
+	return argm >> ARGM_MAX_DATA_SHIFT;
+}
+
 static inline __attribute__((always_inline)) unsigned long
 get_arg_meta(int meta, struct msg_generic_kprobe *e)
 {
@@ -450,6 +572,9 @@ copy_char_buf(void *ctx, char *args, unsigned long arg, int argm,
 	}
 	meta = get_arg_meta(argm, e);
 	probe_read(&bytes, sizeof(bytes), &meta);
+	if (get_max_data(argm))
+		return data_event_bytes(ctx, (struct data_event_desc *)args,
+					arg, bytes, get_max_data(argm));
 	return __copy_char_buf(args, arg, bytes);
 }
 
@@ -593,6 +718,9 @@ copy_char_iovec(void *ctx, char *args, unsigned long arg, int argm,
 		retprobe_map_set_iovec(tid, arg, meta);
 		return return_error(s, char_buf_saved_for_retprobe);
 	}
+	if (get_max_data(argm))
+		return data_event_iovec(ctx, (struct data_event_desc *)args,
+					arg, meta, get_max_data(argm));
 	return __copy_char_iovec(args, arg, meta, 0);
 }
 
@@ -657,7 +785,16 @@ static inline __attribute__((always_inline)) size_t type_to_min_size(int type)
 	case sock_type:
 		return sizeof(struct sk_type);
 	case cred_type:
-		return sizeof(struct msg_capabilities);
+		return sizeof(struct msg_generic_cred);
+	case bpf_attr_type:
+		return sizeof(struct msg_bpf_attr);
+	case module_type:
+	case load_info_type:
+		return MAX_STRING;
+	case linux_binprm_type:
+		return 2 * MAX_STRING;
+	case capability_type:
+		return 4;
 	case size_type:
 	case s64_ty:
 	case u64_ty:
@@ -780,6 +917,9 @@ selector_arg_offset(__u8 *f, struct msg_generic_kprobe *e, __u32 selector)
 		break;
 	case string_type:
 	case char_buf:
+	case module_type:
+	case load_info_type:
+	case linux_binprm_type:
 		pass = filter_char_buf(filter, args);
 		break;
 	case s64_ty:
@@ -790,6 +930,8 @@ selector_arg_offset(__u8 *f, struct msg_generic_kprobe *e, __u32 selector)
 	case int_type:
 	case s32_ty:
 	case u32_ty:
+	case bpf_attr_type:
+	case capability_type:
 		pass = filter_32ty(filter, args);
 		break;
 	default:
@@ -1117,11 +1259,16 @@ read_call_arg(void *ctx, struct msg_generic_kprobe *e, int index, int type,
 				   (char *)&val->file[size - 4]);
 			size += 4;
 		} else {
-			/* If filter specification is fd type then we
-			 * expect the fd has been previously followed
-			 * otherwise drop the event.
+			/* The fd was not followed, e.g. it was opened
+			 * before the policy was loaded. Send it with an
+			 * empty path and flags so that userspace reads
+			 * its name from procfs.
 			 */
-			return -1;
+			__u64 zero = 0;
+
+			probe_read(&args[0], sizeof(__u32), &fd);
+			probe_read(&args[4], sizeof(zero), &zero);
+			size = 4 + 4 + 4;
 		}
 	} break;
 	case filename_ty: {
@@ -1143,6 +1290,7 @@ read_call_arg(void *ctx, struct msg_generic_kprobe *e, int index, int type,
 	case int_type:
 	case s32_ty:
 	case u32_ty:
+	case capability_type:
 		probe_read(args, sizeof(__u32), &arg);
 		size = sizeof(__u32);
 		break;
@@ -1155,6 +1303,18 @@ read_call_arg(void *ctx, struct msg_generic_kprobe *e, int index, int type,
 	case cred_type:
 		size = copy_cred(args, arg);
 		break;
+	case bpf_attr_type:
+		size = copy_bpf_attr(args, arg);
+		break;
+	case module_type:
+		size = copy_module(args, arg);
+		break;
+	case load_info_type:
+		size = copy_load_info(args, arg);
+		break;
+	case linux_binprm_type:
+		size = copy_linux_binprm(args, arg);
+		break;
 	case char_buf:
 		size = copy_char_buf(ctx, args, arg, argm, e);
 		break;
@@ -11,8 +11,35 @@
 package common
 
 const (
-	KeyColor         = "color"          // string
-	KeyDebug         = "debug"          // bool
-	KeyOutput        = "output"         // string
-	KeyServerAddress = "server-address" // string
+	KeyAggregate       = "aggregate"        // bool
+	KeyAggregateArgs   = "aggregate-args"   // []string
+	KeyAggregateBuffer = "aggregate-buffer" // uint64
+	KeyAggregateKeys   = "aggregate-keys"   // []string
+	KeyAggregateWindow = "aggregate-window" // time.Duration
+	KeyArgFilter       = "arg-filter"       // string
+	KeyCelExpression   = "cel-expression"   // string
+	KeyColor           = "color"            // string
+	KeyContainerID     = "container-id"     // []string
+	KeyContainerImage  = "container-image"  // []string
+	KeyDebug           = "debug"            // bool
+	KeyDetectGaps      = "detect-gaps"      // bool
+	KeyEventTypes      = "event-types"      // []string
+	KeyExcludeFields   = "exclude-fields"   // []string
+	KeyFields          = "fields"           // []string
+	KeyFunction        = "function"         // []string
+	KeyHealthCheck     = "health-check"     // bool
+	KeyInput           = "input"            // string
+	KeyLabels          = "labels"           // string
+	KeyLast            = "last"             // uint32
+	KeyNamespace       = "namespace"        // []string
+	KeyNet             = "net"              // string
+	KeyNodeName        = "node-name"        // []string
+	KeyOutput          = "output"           // string
+	KeyPidSet          = "pid-set"          // []string
+	KeyPids            = "pids"             // []string
+	KeyPod             = "pod"              // []string
+	KeyPolicy          = "policy"           // []string
+	KeyProcess         = "process"          // []string
+	KeyServerAddress   = "server-address"   // string
+	KeySince           = "since"            // string
 )
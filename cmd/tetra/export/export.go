@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/isovalent/tetragon-oss/pkg/sequence"
+	"github.com/spf13/cobra"
+)
+
+func New() *cobra.Command {
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Inspect exported events",
+	}
+
+	checkCmd := &cobra.Command{
+		Use:   "check <file>...",
+		Short: "Check that JSON export files contain all the events",
+		Long: `Check that JSON export files contain all the events, using their sequence
+numbers. Rotated files must be given from the oldest to the most recent.
+Export filters and rate limits also cause gaps.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			return checkFiles(cmd.OutOrStdout(), args)
+		},
+	}
+	exportCmd.AddCommand(checkCmd)
+	exportCmd.AddCommand(newReadCmd())
+	exportCmd.AddCommand(newVerifyCmd())
+	return exportCmd
+}
+
+type checkStats struct {
+	events   uint64
+	gaps     uint64
+	missing  uint64
+	restarts uint64
+}
+
+func checkFile(out io.Writer, fname string, checker *sequence.Checker, stats *checkStats) error {
+	f, err := os.Open(fname)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	for line := 1; ; line++ {
+		data, err := r.ReadBytes('\n')
+		if len(bytes.TrimSpace(data)) > 0 {
+			var res fgs.GetEventsResponse
+			if err := json.Unmarshal(data, &res); err != nil {
+				return fmt.Errorf("%s:%d: failed to parse event: %w", fname, line, err)
+			}
+			if res.Event == nil && res.ExportChain != nil {
+				// checkpoint of a hash chain
+				continue
+			}
+			stats.events++
+			gap, restarted := checker.Check(&res)
+			if restarted {
+				stats.restarts++
+				fmt.Fprintf(out, "%s:%d: agent restarted (new agent ID %s), events may have been missed\n", fname, line, res.AgentId)
+			}
+			if gap != nil {
+				stats.gaps++
+				stats.missing += gap.Count()
+				fmt.Fprintf(out, "%s:%d: %d events are missing (sequence %d to %d of agent %s)\n", fname, line, gap.Count(), gap.First, gap.Last, gap.AgentID)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+func checkFiles(out io.Writer, fnames []string) error {
+	var checker sequence.Checker
+	var stats checkStats
+	for _, fname := range fnames {
+		if err := checkFile(out, fname, &checker, &stats); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintf(out, "%d events, %d gaps, %d missing events, %d agent restarts\n", stats.events, stats.gaps, stats.missing, stats.restarts)
+	if stats.gaps > 0 {
+		return fmt.Errorf("export is incomplete")
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	v1 "github.com/cilium/hubble/pkg/api/v1"
+	hubbleFilters "github.com/cilium/hubble/pkg/filters"
+	"github.com/isovalent/tetragon-oss/cmd/tetra/common"
+	"github.com/isovalent/tetragon-oss/pkg/encoder"
+	"github.com/isovalent/tetragon-oss/pkg/exporter/protobuf"
+	"github.com/isovalent/tetragon-oss/pkg/filters"
+	"github.com/isovalent/tetragon-oss/pkg/logger"
+	"github.com/spf13/cobra"
+)
+
+type readOptions struct {
+	output    string
+	color     string
+	allowList string
+	denyList  string
+}
+
+func newReadCmd() *cobra.Command {
+	var opts readOptions
+	cmd := &cobra.Command{
+		Use:   "read <file>...",
+		Short: "Print the events of protobuf export files",
+		Long: `Print the events of files written with the protobuf export encoding, which
+can be compressed with zstd or gzip. "-" reads the standard input. Rotated
+files must be given from the oldest to the most recent.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return readFiles(cmd.OutOrStdout(), args, &opts)
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVarP(&opts.output, common.KeyOutput, "o", "json", "Output format. json, compact, ecs or ocsf")
+	flags.StringVar(&opts.color, common.KeyColor, "auto", "Colorize compact output. auto, always, or never")
+	flags.StringVar(&opts.allowList, "allowlist", "", "JSON encoded allowlist, in the format of the export-allowlist flag of tetragon")
+	flags.StringVar(&opts.denyList, "denylist", "", "JSON encoded denylist, in the format of the export-denylist flag of tetragon")
+	return cmd
+}
+
+func buildFilterList(flag, list string) (hubbleFilters.FilterFuncs, error) {
+	parsed, err := filters.ParseFilterList(list)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", flag, err)
+	}
+	ret, err := filters.BuildFilterList(context.Background(), parsed, filters.Filters)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", flag, err)
+	}
+	return ret, nil
+}
+
+func readFiles(out io.Writer, fnames []string, opts *readOptions) error {
+	allowList, err := buildFilterList("allowlist", opts.allowList)
+	if err != nil {
+		return err
+	}
+	denyList, err := buildFilterList("denylist", opts.denyList)
+	if err != nil {
+		return err
+	}
+	var eventEncoder encoder.EventEncoder
+	switch opts.output {
+	case "json":
+		eventEncoder = json.NewEncoder(out)
+	case "compact":
+		eventEncoder = encoder.NewCompactEncoder(out, encoder.ColorMode(opts.color))
+	case "ecs":
+		eventEncoder = encoder.NewECSEncoder(out)
+	case "ocsf":
+		eventEncoder = encoder.NewOCSFEncoder(out)
+	default:
+		return fmt.Errorf("unknown output format %q", opts.output)
+	}
+
+	for _, fname := range fnames {
+		if err := readFile(fname, func(res *v1.Event) {
+			if !hubbleFilters.Apply(allowList, denyList, res) {
+				return
+			}
+			if err := eventEncoder.Encode(res.Event); err != nil {
+				logger.GetLogger().WithError(err).WithField("event", res.Event).Debug("Failed to encode event")
+			}
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readFile(fname string, fn func(*v1.Event)) error {
+	f := os.Stdin
+	if fname != "-" {
+		var err error
+		if f, err = os.Open(fname); err != nil {
+			return err
+		}
+		defer f.Close()
+	}
+	r, err := protobuf.NewReader(f)
+	if err != nil {
+		return fmt.Errorf("%s: %w", fname, err)
+	}
+	defer r.Close()
+	for n := 1; ; n++ {
+		res, err := r.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("%s: event %d: %w", fname, n, err)
+		}
+		fn(&v1.Event{Event: res})
+	}
+}
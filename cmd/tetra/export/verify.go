@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/isovalent/tetragon-oss/pkg/exporter/chain"
+	"github.com/spf13/cobra"
+)
+
+// verifyOptions allows the records that do not prove that the export files
+// are complete. They are rejected by default, as removing the end of a file,
+// with its checkpoints, and restarting the agent leaves such records.
+type verifyOptions struct {
+	// allowUnsignedTail accepts records that are not covered by a
+	// checkpoint, at the end of a chain.
+	allowUnsignedTail bool
+	// allowRestart accepts new chains, which start after the agent
+	// restarts without resuming the chain of the file.
+	allowRestart bool
+	// allowPartial accepts chains whose beginning is not given, such as
+	// when the rotation removed the oldest files.
+	allowPartial bool
+}
+
+func newVerifyCmd() *cobra.Command {
+	var keyFile string
+	var opts verifyOptions
+	cmd := &cobra.Command{
+		Use:   "verify <file>...",
+		Short: "Verify the hash chain of JSON export files",
+		Long: `Verify that JSON export files written with a hash chain were not altered,
+using the public key of the checkpoints. The lumberjack backups of each file,
+including compressed ones, are verified before it.
+
+Records that are not covered by a checkpoint, new chains and chains whose
+beginning is missing are reported, and fail the verification unless they are
+allowed, as they are also left by the removal of the end of a file.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			return verifyFiles(cmd.OutOrStdout(), keyFile, args, opts)
+		},
+	}
+	cmd.Flags().StringVar(&keyFile, "key", "", "Ed25519 public key (PEM) of the checkpoints. The private key file is also accepted")
+	cmd.MarkFlagRequired("key")
+	cmd.Flags().BoolVar(&opts.allowUnsignedTail, "allow-unsigned-tail", false, "Accept records at the end of a chain that are not covered by a checkpoint")
+	cmd.Flags().BoolVar(&opts.allowRestart, "allow-restart", false, "Accept new chains, started by agent restarts")
+	cmd.Flags().BoolVar(&opts.allowPartial, "allow-partial", false, "Accept chains whose first records are missing, such as after the rotation removed the oldest files")
+	return cmd
+}
+
+type verifyStats struct {
+	records     uint64
+	checkpoints uint64
+	errors      uint64
+	chains      uint64
+	// unsigned, restarts and partial are the records that are rejected
+	// unless they are allowed.
+	unsigned uint64
+	restarts uint64
+	partial  uint64
+}
+
+func verifyFile(out io.Writer, fname string, verifier *chain.Verifier, stats *verifyStats) error {
+	f, err := chain.Open(fname)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	for line := 1; ; line++ {
+		data, err := r.ReadBytes('\n')
+		if len(bytes.TrimSpace(data)) > 0 {
+			unsigned := verifier.Unsigned()
+			kind, verifyErr := verifier.Verify(data)
+			switch kind {
+			case chain.KindCheckpoint:
+				stats.checkpoints++
+			case chain.KindNewChain:
+				stats.records++
+				stats.chains++
+				stats.restarts++
+				if unsigned > 0 {
+					stats.unsigned += unsigned
+					fmt.Fprintf(out, "%s:%d: the previous chain ends with %d records that are not covered by a checkpoint\n", fname, line, unsigned)
+				}
+				fmt.Fprintf(out, "%s:%d: a new chain starts\n", fname, line)
+			case chain.KindPartialChain:
+				stats.records++
+				stats.chains++
+				stats.partial++
+				fmt.Fprintf(out, "%s:%d: the chain starts at record %d, the previous records were not given\n", fname, line, verifier.Index())
+			default:
+				stats.records++
+				if stats.chains == 0 {
+					stats.chains++
+				}
+			}
+			if verifyErr != nil {
+				stats.errors++
+				fmt.Fprintf(out, "%s:%d: %v\n", fname, line, verifyErr)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+func verifyFiles(out io.Writer, keyFile string, args []string, opts verifyOptions) error {
+	key, err := chain.LoadPublicKey(keyFile)
+	if err != nil {
+		return err
+	}
+	var fnames []string
+	seen := map[string]bool{}
+	for _, arg := range args {
+		files, err := chain.Files(arg)
+		if err != nil {
+			return err
+		}
+		if len(files) == 0 {
+			return fmt.Errorf("%s: no such file", arg)
+		}
+		for _, fname := range files {
+			if !seen[fname] {
+				seen[fname] = true
+				fnames = append(fnames, fname)
+			}
+		}
+	}
+
+	verifier := chain.NewVerifier(key)
+	var stats verifyStats
+	for _, fname := range fnames {
+		if err := verifyFile(out, fname, verifier, &stats); err != nil {
+			return err
+		}
+	}
+	if unsigned := verifier.Unsigned(); unsigned > 0 {
+		stats.unsigned += unsigned
+		fmt.Fprintf(out, "the last %d records are not covered by a checkpoint\n", unsigned)
+	}
+	fmt.Fprintf(out, "%d files, %d records, %d checkpoints, %d chains, %d errors\n", len(fnames), stats.records, stats.checkpoints, stats.chains, stats.errors)
+	switch {
+	case stats.errors > 0:
+		return fmt.Errorf("export files were altered")
+	case stats.unsigned > 0 && !opts.allowUnsignedTail:
+		return fmt.Errorf("%d records are not covered by a checkpoint, use --allow-unsigned-tail to accept them", stats.unsigned)
+	case stats.restarts > 0 && !opts.allowRestart:
+		return fmt.Errorf("%d new chains start after the first one, use --allow-restart to accept them", stats.restarts)
+	case stats.partial > 0 && !opts.allowPartial:
+		return fmt.Errorf("the first records of the chain are missing, use --allow-partial to accept it")
+	}
+	return nil
+}
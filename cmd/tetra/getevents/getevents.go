@@ -14,29 +14,265 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/isovalent/tetragon-oss/api/v1/fgs"
 	"github.com/isovalent/tetragon-oss/cmd/tetra/common"
 	"github.com/isovalent/tetragon-oss/pkg/encoder"
 	"github.com/isovalent/tetragon-oss/pkg/logger"
+	"github.com/isovalent/tetragon-oss/pkg/sequence"
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-func getEvents(ctx context.Context, client fgs.FineGuidanceSensorsClient) {
-	stream, err := client.GetEvents(ctx, &fgs.GetEventsRequest{})
+// parseSince parses either a duration relative to now, e.g. "5m", or an
+// RFC 3339 timestamp.
+func parseSince(since string) (time.Time, error) {
+	if d, err := time.ParseDuration(since); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339, since)
 	if err != nil {
-		logger.GetLogger().WithError(err).Fatal("Failed to call GetEvents")
+		return time.Time{}, fmt.Errorf("%q is neither a duration nor an RFC 3339 timestamp", since)
+	}
+	return t, nil
+}
+
+// getStringSlice returns the values of a flag that takes a list, or nil if it
+// is empty, since filters with an empty list match no events.
+func getStringSlice(key string) []string {
+	if ret := viper.GetStringSlice(key); len(ret) > 0 {
+		return ret
+	}
+	return nil
+}
+
+// parseUint32s parses the values of a flag that takes a list of numbers.
+func parseUint32s(key string) ([]uint32, error) {
+	var ret []uint32
+	for _, s := range getStringSlice(key) {
+		v, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s value %q", key, s)
+		}
+		ret = append(ret, uint32(v))
+	}
+	return ret, nil
+}
+
+// parseEventTypes parses event types such as process_exec or PROCESS_EXEC.
+func parseEventTypes(types []string) ([]fgs.EventType, error) {
+	var ret []fgs.EventType
+	for _, t := range types {
+		v, ok := fgs.EventType_value[strings.ToUpper(t)]
+		if !ok || v == int32(fgs.EventType_UNDEF) {
+			return nil, fmt.Errorf("unknown event type %q", t)
+		}
+		ret = append(ret, fgs.EventType(v))
+	}
+	return ret, nil
+}
+
+// parseArgFilters parses one or more argument filters in JSON format, e.g.
+// {"index": 0, "regex": ["^/etc/"]}.
+func parseArgFilters(filters string) ([]*fgs.ArgumentFilter, error) {
+	dec := json.NewDecoder(strings.NewReader(filters))
+	var ret []*fgs.ArgumentFilter
+	for {
+		var af fgs.ArgumentFilter
+		if err := dec.Decode(&af); err != nil {
+			if err == io.EOF {
+				return ret, nil
+			}
+			return nil, fmt.Errorf("invalid --%s: %w", common.KeyArgFilter, err)
+		}
+		ret = append(ret, &af)
+	}
+}
+
+func getFilter() (*fgs.Filter, error) {
+	filter := &fgs.Filter{
+		BinaryRegex:         getStringSlice(common.KeyProcess),
+		Namespace:           getStringSlice(common.KeyNamespace),
+		PodRegex:            getStringSlice(common.KeyPod),
+		ContainerId:         getStringSlice(common.KeyContainerID),
+		ContainerImageRegex: getStringSlice(common.KeyContainerImage),
+		NodeName:            getStringSlice(common.KeyNodeName),
+		FunctionName:        getStringSlice(common.KeyFunction),
+		PolicyName:          getStringSlice(common.KeyPolicy),
+	}
+	var err error
+	if filter.Pid, err = parseUint32s(common.KeyPids); err != nil {
+		return nil, err
+	}
+	if filter.PidSet, err = parseUint32s(common.KeyPidSet); err != nil {
+		return nil, err
+	}
+	if filter.EventSet, err = parseEventTypes(getStringSlice(common.KeyEventTypes)); err != nil {
+		return nil, err
+	}
+	if viper.IsSet(common.KeyHealthCheck) {
+		filter.HealthCheck = wrapperspb.Bool(viper.GetBool(common.KeyHealthCheck))
+	}
+	if labels := viper.GetString(common.KeyLabels); labels != "" {
+		filter.Labels = []string{labels}
 	}
-	var eventEncoder encoder.EventEncoder
-	if viper.GetString(common.KeyOutput) == "compact" {
+	if argFilter := viper.GetString(common.KeyArgFilter); argFilter != "" {
+		if filter.ArgFilter, err = parseArgFilters(argFilter); err != nil {
+			return nil, err
+		}
+	}
+	if expr := viper.GetString(common.KeyCelExpression); expr != "" {
+		filter.CelExpression = []string{expr}
+	}
+	if net := viper.GetString(common.KeyNet); net != "" {
+		filter.NetworkExpression = []string{net}
+	}
+	return filter, nil
+}
+
+func getAggregationOptions() (*fgs.AggregationOptions, error) {
+	if !viper.GetBool(common.KeyAggregate) {
+		return nil, nil
+	}
+	window := viper.GetDuration(common.KeyAggregateWindow)
+	if window <= 0 {
+		return nil, fmt.Errorf("invalid --%s: must be positive", common.KeyAggregateWindow)
+	}
+	keyArgs, err := parseUint32s(common.KeyAggregateArgs)
+	if err != nil {
+		return nil, err
+	}
+	return &fgs.AggregationOptions{
+		WindowSize:        durationpb.New(window),
+		ChannelBufferSize: viper.GetUint64(common.KeyAggregateBuffer),
+		KeyFields:         getStringSlice(common.KeyAggregateKeys),
+		KeyArgs:           keyArgs,
+	}, nil
+}
+
+func getFieldMask() *fgs.EventFieldMask {
+	var mask fgs.EventFieldMask
+	if fields := getStringSlice(common.KeyFields); len(fields) > 0 {
+		mask.Include = &fieldmaskpb.FieldMask{Paths: fields}
+	}
+	if fields := getStringSlice(common.KeyExcludeFields); len(fields) > 0 {
+		mask.Exclude = &fieldmaskpb.FieldMask{Paths: fields}
+	}
+	if mask.Include == nil && mask.Exclude == nil {
+		return nil
+	}
+	return &mask
+}
+
+func getRequest() (*fgs.GetEventsRequest, error) {
+	request := &fgs.GetEventsRequest{
+		LastN: viper.GetUint32(common.KeyLast),
+	}
+	if since := viper.GetString(common.KeySince); since != "" {
+		t, err := parseSince(since)
+		if err != nil {
+			return nil, err
+		}
+		request.Since = timestamppb.New(t)
+	}
+
+	filter, err := getFilter()
+	if err != nil {
+		return nil, err
+	}
+	if !proto.Equal(filter, &fgs.Filter{}) {
+		request.AllowList = []*fgs.Filter{filter}
+	}
+	if request.AggregationOptions, err = getAggregationOptions(); err != nil {
+		return nil, err
+	}
+	if mask := getFieldMask(); mask != nil {
+		request.FieldMask = []*fgs.EventFieldMask{mask}
+	}
+	return request, nil
+}
+
+func getEncoder(out io.Writer) (encoder.EventEncoder, error) {
+	switch output := viper.GetString(common.KeyOutput); output {
+	case "json":
+		return json.NewEncoder(out), nil
+	case "compact":
 		colorMode := encoder.ColorMode(viper.GetString(common.KeyColor))
-		eventEncoder = encoder.NewCompactEncoder(os.Stdout, colorMode)
-	} else {
-		eventEncoder = json.NewEncoder(os.Stdout)
+		return encoder.NewCompactEncoder(out, colorMode), nil
+	case "ecs":
+		return encoder.NewECSEncoder(out), nil
+	case "ocsf":
+		return encoder.NewOCSFEncoder(out), nil
+	default:
+		return nil, fmt.Errorf("unknown output format %q", output)
+	}
+}
+
+func checkSequence(checker *sequence.Checker, res *fgs.GetEventsResponse) {
+	gap, restarted := checker.Check(res)
+	if restarted {
+		logger.GetLogger().WithField("agentID", res.AgentId).Warn("Agent restarted, events may have been missed")
+	}
+	if gap != nil {
+		logger.GetLogger().WithFields(logrus.Fields{
+			"agentID": gap.AgentID,
+			"first":   gap.First,
+			"last":    gap.Last,
+		}).Warnf("Missed %d events", gap.Count())
+	}
+}
+
+// printer prints the events of getevents, whether they are received from the
+// agent or read from a file.
+type printer struct {
+	encoder encoder.EventEncoder
+	checker *sequence.Checker
+}
+
+func newPrinter() *printer {
+	eventEncoder, err := getEncoder(os.Stdout)
+	if err != nil {
+		logger.GetLogger().WithError(err).Fatal("Invalid output")
+	}
+	p := &printer{encoder: eventEncoder}
+	if viper.GetBool(common.KeyDetectGaps) {
+		p.checker = &sequence.Checker{}
+	}
+	return p
+}
+
+func (p *printer) print(res *fgs.GetEventsResponse) {
+	if p.checker != nil {
+		checkSequence(p.checker, res)
+	}
+	if err := p.encoder.Encode(res); err != nil {
+		logger.GetLogger().WithError(err).WithField("event", res).Debug("Failed to encode event")
+	}
+}
+
+func getEvents(ctx context.Context, client fgs.FineGuidanceSensorsClient) {
+	request, err := getRequest()
+	if err != nil {
+		logger.GetLogger().WithError(err).Fatal("Invalid request")
+	}
+	p := newPrinter()
+	stream, err := client.GetEvents(ctx, request)
+	if err != nil {
+		logger.GetLogger().WithError(err).Fatal("Failed to call GetEvents")
 	}
 	for {
 		res, err := stream.Recv()
@@ -46,9 +282,17 @@ func getEvents(ctx context.Context, client fgs.FineGuidanceSensorsClient) {
 			}
 			return
 		}
-		if err = eventEncoder.Encode(res); err != nil {
-			logger.GetLogger().WithError(err).WithField("event", res).Debug("Failed to encode event")
-		}
+		p.print(res)
+	}
+}
+
+func getInputEvents(fname string) {
+	request, err := getRequest()
+	if err != nil {
+		logger.GetLogger().WithError(err).Fatal("Invalid request")
+	}
+	if err := readInput(fname, request, newPrinter()); err != nil {
+		logger.GetLogger().WithError(err).Fatal("Failed to read events")
 	}
 }
 
@@ -56,14 +300,55 @@ func New() *cobra.Command {
 	cmd := cobra.Command{
 		Use:   "getevents",
 		Short: "Print events",
+		Long: `Print the events of the agent. Filters, aggregation and field selection are
+applied by the agent. With --input, the events of a JSON export file are
+printed instead, with the same filters and field selection, and no agent
+is needed.`,
 		Run: func(cmd *cobra.Command, args []string) {
+			if input := viper.GetString(common.KeyInput); input != "" {
+				getInputEvents(input)
+				return
+			}
 			common.CliRun(getEvents)
 		},
 	}
 
 	flags := cmd.Flags()
-	flags.StringP("output", "o", "json", "Output format. json or compact")
+	flags.StringP("output", "o", "json", "Output format. json, compact, ecs (Elastic Common Schema) or ocsf (Open Cybersecurity Schema Framework)")
 	flags.String("color", "auto", "Colorize compact output. auto, always, or never")
+	flags.StringP(common.KeyInput, "i", "", "Read the events of a JSON export file, which can be gzip compressed, instead of the agent. \"-\" reads the standard input")
+	flags.String(common.KeySince, "", "Replay the events observed since a time, either a duration like \"5m\" or an RFC 3339 timestamp, before printing live events")
+	flags.Uint32(common.KeyLast, 0, "Replay up to this many recent events before printing live events")
+	flags.Bool(common.KeyDetectGaps, false, "Warn about the events that were not received, using their sequence numbers. Filters also cause gaps")
+
+	// filters
+	flags.StringSlice(common.KeyNamespace, nil, "Only print events of pods in these Kubernetes namespaces")
+	flags.StringSlice(common.KeyProcess, nil, "Only print events of processes whose binary matches these regular expressions")
+	flags.StringSlice(common.KeyPod, nil, "Only print events of pods whose name matches these regular expressions")
+	flags.StringSlice(common.KeyEventTypes, nil, "Only print events of these types, e.g. process_exec,process_kprobe")
+	flags.StringSlice(common.KeyPids, nil, "Only print events of these process IDs")
+	flags.StringSlice(common.KeyPidSet, nil, "Only print events of these process IDs and their descendants")
+	flags.StringSlice(common.KeyContainerID, nil, "Only print events of containers whose ID starts with these prefixes")
+	flags.StringSlice(common.KeyContainerImage, nil, "Only print events of containers whose image matches these regular expressions")
+	flags.String(common.KeyLabels, "", "Only print events of pods matching a Kubernetes label selector, e.g. \"app=nginx,tier in (frontend)\"")
+	flags.StringSlice(common.KeyNodeName, nil, "Only print events of these nodes")
+	flags.StringSlice(common.KeyFunction, nil, "Only print kprobe events of these functions, and tracepoint events of these \"subsys/event\"")
+	flags.StringSlice(common.KeyPolicy, nil, "Only print kprobe and tracepoint events of these tracing policies")
+	flags.String(common.KeyArgFilter, "", "Only print kprobe and tracepoint events with arguments matching JSON argument filters, e.g. '{\"index\": 0, \"regex\": [\"^/etc/\"]}'")
+	flags.Bool(common.KeyHealthCheck, false, "Only print the events of health checks if true, or the other events if false")
+	flags.String(common.KeyCelExpression, "", "Only print events matching a CEL expression, e.g. \"event.process_exec.process.pod.namespace == 'default'\"")
+	flags.String(common.KeyNet, "", "Only print events with network arguments matching a tcpdump-like expression, e.g. \"tcp and dport 443\"")
+
+	// aggregation
+	flags.Bool(common.KeyAggregate, false, "Aggregate the kprobe and tracepoint events with the same key")
+	flags.Duration(common.KeyAggregateWindow, 15*time.Second, "Window of the aggregation of events")
+	flags.Uint64(common.KeyAggregateBuffer, 1000, "Size of the buffer of the events that the agent aggregates")
+	flags.StringSlice(common.KeyAggregateKeys, nil, "Paths of the fields of the aggregation key, e.g. process.exec_id,function_name. Defaults to the process and the function or tracepoint")
+	flags.StringSlice(common.KeyAggregateArgs, nil, "Indexes of the arguments that are also part of the aggregation key")
+
+	// field selection
+	flags.StringSlice(common.KeyFields, nil, "Only print these fields of the events, e.g. process.binary,process.pod.name")
+	flags.StringSlice(common.KeyExcludeFields, nil, "Do not print these fields of the events, e.g. parent")
 	viper.BindPFlags(flags)
 	return &cmd
 }
@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package getevents
+
+import (
+	"testing"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/isovalent/tetragon-oss/cmd/tetra/common"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// setFlags sets the values of flags for the duration of a test.
+func setFlags(t *testing.T, flags map[string]interface{}) {
+	for key, value := range flags {
+		viper.Set(key, value)
+	}
+	t.Cleanup(viper.Reset)
+}
+
+func TestParseEventTypes(t *testing.T) {
+	tests := []struct {
+		types []string
+		want  []fgs.EventType
+		err   bool
+	}{
+		{types: nil},
+		{types: []string{"process_exec"}, want: []fgs.EventType{fgs.EventType_PROCESS_EXEC}},
+		{types: []string{"PROCESS_EXIT", "process_kprobe"}, want: []fgs.EventType{fgs.EventType_PROCESS_EXIT, fgs.EventType_PROCESS_KPROBE}},
+		{types: []string{"process_exec", "exec"}, err: true},
+		{types: []string{"undef"}, err: true},
+	}
+	for _, tt := range tests {
+		got, err := parseEventTypes(tt.types)
+		if tt.err {
+			assert.Error(t, err, tt.types)
+			continue
+		}
+		require.NoError(t, err, tt.types)
+		assert.Equal(t, tt.want, got, tt.types)
+	}
+}
+
+func TestParseArgFilters(t *testing.T) {
+	tests := []struct {
+		filters string
+		want    []*fgs.ArgumentFilter
+		err     bool
+	}{
+		{filters: `{"index": 0, "regex": ["^/etc/"]}`, want: []*fgs.ArgumentFilter{{Index: wrapperspb.UInt32(0), Regex: []string{"^/etc/"}}}},
+		{
+			filters: `{"index": 0, "regex": ["^/etc/"]} {"index": 1, "regex": ["passwd"]}`,
+			want:    []*fgs.ArgumentFilter{{Index: wrapperspb.UInt32(0), Regex: []string{"^/etc/"}}, {Index: wrapperspb.UInt32(1), Regex: []string{"passwd"}}},
+		},
+		{filters: `{"index": 0, "regex": "^/etc/"}`, err: true},
+		{filters: `{"index": 0`, err: true},
+	}
+	for _, tt := range tests {
+		got, err := parseArgFilters(tt.filters)
+		if tt.err {
+			assert.Error(t, err, tt.filters)
+			continue
+		}
+		require.NoError(t, err, tt.filters)
+		require.Len(t, got, len(tt.want), tt.filters)
+		for i := range got {
+			assert.True(t, proto.Equal(tt.want[i], got[i]), "%s: %v", tt.filters, got[i])
+		}
+	}
+}
+
+func TestGetFilter(t *testing.T) {
+	tests := []struct {
+		name  string
+		flags map[string]interface{}
+		want  *fgs.Filter
+		err   bool
+	}{
+		{name: "no flags", want: &fgs.Filter{}},
+		{
+			name:  "empty lists",
+			flags: map[string]interface{}{common.KeyProcess: []string{}, common.KeyPids: []string{}},
+			want:  &fgs.Filter{},
+		},
+		{
+			name: "filters",
+			flags: map[string]interface{}{
+				common.KeyProcess:     []string{"curl", "wget"},
+				common.KeyNamespace:   []string{"default"},
+				common.KeyPids:        []string{"1", "42"},
+				common.KeyEventTypes:  []string{"process_exec"},
+				common.KeyHealthCheck: false,
+				common.KeyLabels:      "app=nginx",
+				common.KeyArgFilter:   `{"index": 1, "regex": ["^/etc/"]}`,
+			},
+			want: &fgs.Filter{
+				BinaryRegex: []string{"curl", "wget"},
+				Namespace:   []string{"default"},
+				Pid:         []uint32{1, 42},
+				EventSet:    []fgs.EventType{fgs.EventType_PROCESS_EXEC},
+				HealthCheck: wrapperspb.Bool(false),
+				Labels:      []string{"app=nginx"},
+				ArgFilter:   []*fgs.ArgumentFilter{{Index: wrapperspb.UInt32(1), Regex: []string{"^/etc/"}}},
+			},
+		},
+		{name: "invalid pid", flags: map[string]interface{}{common.KeyPids: []string{"-1"}}, err: true},
+		{name: "invalid pid set", flags: map[string]interface{}{common.KeyPidSet: []string{"init"}}, err: true},
+		{name: "invalid event type", flags: map[string]interface{}{common.KeyEventTypes: []string{"exec"}}, err: true},
+		{name: "invalid argument filter", flags: map[string]interface{}{common.KeyArgFilter: `{"index": "a"}`}, err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setFlags(t, tt.flags)
+			got, err := getFilter()
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(tt.want, got), "%v", got)
+		})
+	}
+}
+
+func TestGetRequest(t *testing.T) {
+	t.Run("no flags", func(t *testing.T) {
+		setFlags(t, nil)
+		request, err := getRequest()
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(&fgs.GetEventsRequest{}, request), "%v", request)
+	})
+
+	t.Run("replay and filters", func(t *testing.T) {
+		setFlags(t, map[string]interface{}{
+			common.KeySince:   "5m",
+			common.KeyLast:    10,
+			common.KeyProcess: []string{"curl"},
+			common.KeyFields:  []string{"process.binary"},
+		})
+		request, err := getRequest()
+		require.NoError(t, err)
+		assert.Equal(t, uint32(10), request.LastN)
+		assert.WithinDuration(t, time.Now().Add(-5*time.Minute), request.Since.AsTime(), time.Minute)
+		require.Len(t, request.AllowList, 1)
+		assert.Equal(t, []string{"curl"}, request.AllowList[0].BinaryRegex)
+		require.Len(t, request.FieldMask, 1)
+		assert.Equal(t, []string{"process.binary"}, request.FieldMask[0].Include.Paths)
+		assert.Nil(t, request.FieldMask[0].Exclude)
+		assert.Nil(t, request.AggregationOptions)
+	})
+
+	t.Run("since timestamp", func(t *testing.T) {
+		setFlags(t, map[string]interface{}{common.KeySince: "2022-05-17T10:30:00Z"})
+		request, err := getRequest()
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2022, 5, 17, 10, 30, 0, 0, time.UTC), request.Since.AsTime())
+	})
+
+	t.Run("aggregation", func(t *testing.T) {
+		setFlags(t, map[string]interface{}{
+			common.KeyAggregate:       true,
+			common.KeyAggregateWindow: time.Minute,
+			common.KeyAggregateArgs:   []string{"0", "2"},
+		})
+		request, err := getRequest()
+		require.NoError(t, err)
+		require.NotNil(t, request.AggregationOptions)
+		assert.Equal(t, time.Minute, request.AggregationOptions.WindowSize.AsDuration())
+		assert.Equal(t, []uint32{0, 2}, request.AggregationOptions.KeyArgs)
+	})
+
+	for name, flags := range map[string]map[string]interface{}{
+		"invalid since":            {common.KeySince: "yesterday"},
+		"invalid filter":           {common.KeyPids: []string{"a"}},
+		"invalid aggregation args": {common.KeyAggregate: true, common.KeyAggregateWindow: time.Minute, common.KeyAggregateArgs: []string{"a"}},
+		"invalid window":           {common.KeyAggregate: true, common.KeyAggregateWindow: time.Duration(0)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			setFlags(t, flags)
+			_, err := getRequest()
+			assert.Error(t, err)
+		})
+	}
+}
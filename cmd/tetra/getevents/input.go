@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package getevents
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	v1 "github.com/cilium/hubble/pkg/api/v1"
+	hubbleFilters "github.com/cilium/hubble/pkg/filters"
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/isovalent/tetragon-oss/pkg/exporter/chain"
+	"github.com/isovalent/tetragon-oss/pkg/fieldmask"
+	"github.com/isovalent/tetragon-oss/pkg/filters"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// isNotification returns true for the events that the agent sends regardless
+// of the filters, such as the rate limit notifications.
+func isNotification(event *fgs.GetEventsResponse) bool {
+	switch event.Event.(type) {
+	case *fgs.GetEventsResponse_EventsDropped, *fgs.GetEventsResponse_LostEvents, *fgs.GetEventsResponse_RateLimitInfo:
+		return true
+	}
+	return false
+}
+
+var unmarshalOptions = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+// readEvents calls fn for each event of a JSON export file. Checkpoints of
+// hash chains are skipped, and so are the fields that this version does not
+// know, such as the ones of files written by more recent agents.
+func readEvents(fname string, fn func(*fgs.GetEventsResponse)) error {
+	var f io.ReadCloser = os.Stdin
+	if fname != "-" {
+		var err error
+		if f, err = chain.Open(fname); err != nil {
+			return err
+		}
+		defer f.Close()
+	}
+
+	r := bufio.NewReader(f)
+	for line := 1; ; line++ {
+		data, err := r.ReadBytes('\n')
+		if len(bytes.TrimSpace(data)) > 0 {
+			var res fgs.GetEventsResponse
+			if err := unmarshalOptions.Unmarshal(data, &res); err != nil {
+				return fmt.Errorf("%s:%d: failed to parse event: %w", fname, line, err)
+			}
+			if res.Event == nil && res.ExportChain != nil {
+				continue
+			}
+			res.ExportChain = nil
+			fn(&res)
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+// readInput prints the events of a JSON export file. The filters, the field
+// masks and the since and last_n limits of the request apply as they do in
+// the agent, with since compared to the time of the events.
+func readInput(fname string, request *fgs.GetEventsRequest, p *printer) error {
+	if request.AggregationOptions != nil {
+		return errors.New("aggregation is not supported with an input file")
+	}
+	allowList, err := filters.BuildFilterList(context.Background(), request.AllowList, filters.Filters)
+	if err != nil {
+		return fmt.Errorf("invalid filter: %w", err)
+	}
+	denyList, err := filters.BuildFilterList(context.Background(), request.DenyList, filters.Filters)
+	if err != nil {
+		return fmt.Errorf("invalid filter: %w", err)
+	}
+	fieldMasks, err := fieldmask.New(request.FieldMask)
+	if err != nil {
+		return fmt.Errorf("invalid field selection: %w", err)
+	}
+
+	// the last events, printed at the end of the file when last_n is set
+	var last []*fgs.GetEventsResponse
+	err = readEvents(fname, func(res *fgs.GetEventsResponse) {
+		if request.Since != nil && res.Time.AsTime().Before(request.Since.AsTime()) {
+			return
+		}
+		if !isNotification(res) && !hubbleFilters.Apply(allowList, denyList, &v1.Event{Event: res}) {
+			return
+		}
+		res = fieldMasks.Apply(res)
+		if request.LastN == 0 {
+			p.print(res)
+			return
+		}
+		last = append(last, res)
+		if len(last) > int(request.LastN) {
+			last = last[1:]
+		}
+	})
+	for _, res := range last {
+		p.print(res)
+	}
+	return err
+}
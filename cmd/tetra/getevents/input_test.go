@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package getevents
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// testInput is an export file with a hash chain, whose checkpoints are not
+// events, a notification and a field that this version does not know.
+var testInput = strings.Join([]string{
+	`{"process_exec":{"process":{"binary":"/bin/a"}},"time":"2022-05-17T10:00:00Z","export_chain":{"index":"1","hash":"00"}}`,
+	`{"process_exec":{"process":{"binary":"/bin/b"}},"time":"2022-05-17T10:01:00Z","export_chain":{"index":"2","hash":"01"}}`,
+	`{"export_chain":{"index":"2","hash":"01","signature":"AA==","key_id":"00"}}`,
+	`{"lost_events":{"count":"3"},"time":"2022-05-17T10:02:00Z"}`,
+	``,
+	`{"process_exec":{"process":{"binary":"/bin/a"}},"time":"2022-05-17T10:03:00Z","new_field":{"value":1}}`,
+}, "\n") + "\n"
+
+// summary returns the binary of each printed event, or the type of the
+// notifications.
+func summary(t *testing.T, output []byte) []string {
+	var ret []string
+	for _, line := range bytes.Split(bytes.TrimSpace(output), []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		var res fgs.GetEventsResponse
+		require.NoError(t, json.Unmarshal(line, &res))
+		assert.Nil(t, res.ExportChain)
+		if process := res.GetProcessExec().GetProcess(); process != nil {
+			ret = append(ret, process.Binary)
+		} else {
+			ret = append(ret, "lost")
+		}
+	}
+	return ret
+}
+
+func TestReadInput(t *testing.T) {
+	fname := filepath.Join(t.TempDir(), "events.log")
+	require.NoError(t, os.WriteFile(fname, []byte(testInput), 0o600))
+	since := timestamppb.New(time.Date(2022, 5, 17, 10, 1, 0, 0, time.UTC))
+
+	tests := []struct {
+		name    string
+		request *fgs.GetEventsRequest
+		want    []string
+	}{
+		{
+			name:    "all events",
+			request: &fgs.GetEventsRequest{},
+			want:    []string{"/bin/a", "/bin/b", "lost", "/bin/a"},
+		},
+		{
+			name:    "notifications bypass the filters",
+			request: &fgs.GetEventsRequest{AllowList: []*fgs.Filter{{BinaryRegex: []string{"/bin/b"}}}},
+			want:    []string{"/bin/b", "lost"},
+		},
+		{
+			name:    "deny list",
+			request: &fgs.GetEventsRequest{DenyList: []*fgs.Filter{{BinaryRegex: []string{"/bin/a"}}}},
+			want:    []string{"/bin/b", "lost"},
+		},
+		{
+			name:    "since",
+			request: &fgs.GetEventsRequest{Since: since},
+			want:    []string{"/bin/b", "lost", "/bin/a"},
+		},
+		{
+			name:    "last n",
+			request: &fgs.GetEventsRequest{LastN: 2},
+			want:    []string{"lost", "/bin/a"},
+		},
+		{
+			name:    "since and last n",
+			request: &fgs.GetEventsRequest{Since: since, LastN: 5},
+			want:    []string{"/bin/b", "lost", "/bin/a"},
+		},
+		{
+			name:    "filters and last n",
+			request: &fgs.GetEventsRequest{AllowList: []*fgs.Filter{{BinaryRegex: []string{"/bin/a"}}}, LastN: 1},
+			want:    []string{"/bin/a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, readInput(fname, tt.request, &printer{encoder: json.NewEncoder(&buf)}))
+			assert.Equal(t, tt.want, summary(t, buf.Bytes()))
+		})
+	}
+}
+
+func TestReadInputErrors(t *testing.T) {
+	dir := t.TempDir()
+	fname := filepath.Join(dir, "events.log")
+	require.NoError(t, os.WriteFile(fname, []byte(testInput), 0o600))
+	p := &printer{encoder: json.NewEncoder(&bytes.Buffer{})}
+
+	err := readInput(fname, &fgs.GetEventsRequest{AggregationOptions: &fgs.AggregationOptions{}}, p)
+	assert.Error(t, err, "aggregation is not supported")
+	err = readInput(fname, &fgs.GetEventsRequest{AllowList: []*fgs.Filter{{BinaryRegex: []string{"["}}}}, p)
+	assert.Error(t, err, "invalid filters are rejected")
+	err = readInput(filepath.Join(dir, "missing.log"), &fgs.GetEventsRequest{}, p)
+	assert.Error(t, err)
+
+	invalid := filepath.Join(dir, "invalid.log")
+	require.NoError(t, os.WriteFile(invalid, []byte(`{"process_exec":{"process":{"binary":1}}}`+"\n"), 0o600))
+	err = readInput(invalid, &fgs.GetEventsRequest{}, p)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid.log:1")
+}
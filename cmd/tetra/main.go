@@ -6,6 +6,7 @@ import (
 
 	"github.com/isovalent/tetragon-oss/cmd/tetra/bugtool"
 	"github.com/isovalent/tetragon-oss/cmd/tetra/common"
+	"github.com/isovalent/tetragon-oss/cmd/tetra/export"
 	"github.com/isovalent/tetragon-oss/cmd/tetra/getevents"
 	"github.com/isovalent/tetragon-oss/cmd/tetra/sensors"
 	"github.com/isovalent/tetragon-oss/cmd/tetra/stacktracetree"
@@ -44,6 +45,7 @@ func new() *cobra.Command {
 	}
 
 	rootCmd.AddCommand(bugtool.New())
+	rootCmd.AddCommand(export.New())
 	rootCmd.AddCommand(getevents.New())
 	rootCmd.AddCommand(sensors.New())
 	rootCmd.AddCommand(stacktracetree.New())
@@ -30,6 +30,13 @@ const (
 	keyProcessCacheSize = "process-cache-size"
 	keyForceSmallProgs  = "force-small-progs"
 
+	keyEnablePathResolution = "enable-path-resolution"
+
+	keyEventHistorySize   = "event-history-size"
+	keyEventHistoryMaxAge = "event-history-max-age"
+
+	keyPerfRingBufferSize = "perf-ring-buffer-size"
+
 	keyLogLevel  = "log-level"
 	keyLogFormat = "log-format"
 
@@ -48,18 +55,28 @@ const (
 	keyIgnoreMissingProgs = "ignore-missing-progs"
 
 	keyExportFilename             = "export-filename"
+	keyExportConfig               = "export-config"
+	keyNotifierConfig             = "notifier-config"
 	keyExportFileMaxSizeMB        = "export-file-max-size-mb"
 	keyExportFileRotationInterval = "export-file-rotation-interval"
 	keyExportFileMaxBackups       = "export-file-max-backups"
 	keyExportFileCompress         = "export-file-compress"
 	keyExportRateLimit            = "export-rate-limit"
+	keyExportBufferSize           = "export-buffer-size"
+	keyExportDropPolicy           = "export-drop-policy"
+
+	keyExportHashChainKey                = "export-hash-chain-key"
+	keyExportHashChainCheckpointInterval = "export-hash-chain-checkpoint-interval"
 
 	keyEnableExportAggregation     = "enable-export-aggregation"
 	keyExportAggregationWindowSize = "export-aggregation-window-size"
 	keyExportAggregationBufferSize = "export-aggregation-buffer-size"
+	keyExportAggregationKeyFields  = "export-aggregation-key-fields"
+	keyExportAggregationKeyArgs    = "export-aggregation-key-args"
 
 	keyExportAllowlist = "export-allowlist"
 	keyExportDenylist  = "export-denylist"
+	keyExportFieldMask = "export-field-mask"
 
 	keyNetnsDir = "netns-dir"
 )
@@ -80,16 +97,26 @@ var (
 	runStandalone bool
 
 	exportFilename             string
+	exportConfig               string
+	notifierConfig             string
 	exportFileMaxSizeMB        int
 	exportFileRotationInterval time.Duration
 	exportFileMaxBackups       int
 	exportFileCompress         bool
 	exportRateLimit            int
+	exportBufferSize           uint32
+	exportDropPolicy           string
+
+	// Export hash chain options
+	exportHashChainKey                string
+	exportHashChainCheckpointInterval time.Duration
 
 	// Export aggregation options
 	enableExportAggregation     bool
 	exportAggregationWindowSize time.Duration
 	exportAggregationBufferSize uint64
+	exportAggregationKeyFields  []string
+	exportAggregationKeyArgs    []int
 )
 
 func readAndSetFlags() {
@@ -101,6 +128,10 @@ func readAndSetFlags() {
 	option.Config.IgnoreMissingProgs = viper.GetBool(keyIgnoreMissingProgs)
 	option.Config.ForceSmallProgs = viper.GetBool(keyForceSmallProgs)
 	option.Config.Debug = viper.GetBool(keyDebug)
+	option.Config.EnablePathResolution = viper.GetBool(keyEnablePathResolution)
+	option.Config.EventHistorySize = viper.GetInt(keyEventHistorySize)
+	option.Config.EventHistoryMaxAge = viper.GetDuration(keyEventHistoryMaxAge)
+	option.Config.PerfRingBufferSize = viper.GetInt(keyPerfRingBufferSize)
 
 	logLevel := viper.GetString(keyLogLevel)
 	logFormat := viper.GetString(keyLogFormat)
@@ -121,13 +152,22 @@ func readAndSetFlags() {
 	runStandalone = viper.GetBool(keyRunStandalone)
 
 	exportFilename = viper.GetString(keyExportFilename)
+	exportConfig = viper.GetString(keyExportConfig)
+	notifierConfig = viper.GetString(keyNotifierConfig)
 	exportFileMaxSizeMB = viper.GetInt(keyExportFileMaxSizeMB)
 	exportFileRotationInterval = viper.GetDuration(keyExportFileRotationInterval)
 	exportFileMaxBackups = viper.GetInt(keyExportFileMaxBackups)
 	exportFileCompress = viper.GetBool(keyExportFileCompress)
 	exportRateLimit = viper.GetInt(keyExportRateLimit)
+	exportBufferSize = viper.GetUint32(keyExportBufferSize)
+	exportDropPolicy = viper.GetString(keyExportDropPolicy)
+
+	exportHashChainKey = viper.GetString(keyExportHashChainKey)
+	exportHashChainCheckpointInterval = viper.GetDuration(keyExportHashChainCheckpointInterval)
 
 	enableExportAggregation = viper.GetBool(keyEnableExportAggregation)
 	exportAggregationWindowSize = viper.GetDuration(keyExportAggregationWindowSize)
 	exportAggregationBufferSize = viper.GetUint64(keyExportAggregationBufferSize)
+	exportAggregationKeyFields = viper.GetStringSlice(keyExportAggregationKeyFields)
+	exportAggregationKeyArgs = viper.GetIntSlice(keyExportAggregationKeyArgs)
 }
@@ -2,7 +2,7 @@ package main
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 	"net"
 	"os"
 	"os/signal"
@@ -17,14 +17,16 @@ import (
 	"github.com/isovalent/tetragon-oss/pkg/cilium"
 	"github.com/isovalent/tetragon-oss/pkg/defaults"
 	"github.com/isovalent/tetragon-oss/pkg/exporter"
+	"github.com/isovalent/tetragon-oss/pkg/exporter/chain"
+	"github.com/isovalent/tetragon-oss/pkg/fieldmask"
 	"github.com/isovalent/tetragon-oss/pkg/filters"
 	fgsGrpc "github.com/isovalent/tetragon-oss/pkg/grpc"
 	"github.com/isovalent/tetragon-oss/pkg/logger"
 	"github.com/isovalent/tetragon-oss/pkg/metrics"
+	"github.com/isovalent/tetragon-oss/pkg/notifier"
 	"github.com/isovalent/tetragon-oss/pkg/observer"
 	"github.com/isovalent/tetragon-oss/pkg/option"
 	"github.com/isovalent/tetragon-oss/pkg/process"
-	"github.com/isovalent/tetragon-oss/pkg/ratelimit"
 	"github.com/isovalent/tetragon-oss/pkg/sensors"
 	"github.com/isovalent/tetragon-oss/pkg/server"
 	"github.com/isovalent/tetragon-oss/pkg/version"
@@ -35,13 +37,12 @@ import (
 	_ "github.com/isovalent/tetragon-oss/pkg/sensors"
 
 	ciliumopt "github.com/cilium/cilium/pkg/option"
-	"github.com/cilium/lumberjack/v2"
 	gops "github.com/google/gops/agent"
-	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/durationpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 )
@@ -53,15 +54,23 @@ var (
 func getExportFilters() ([]*fgs.Filter, []*fgs.Filter, error) {
 	allowList, err := filters.ParseFilterList(viper.GetString(keyExportAllowlist))
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, fmt.Errorf("failed to parse %s: %w", keyExportAllowlist, err)
 	}
 	denyList, err := filters.ParseFilterList(viper.GetString(keyExportDenylist))
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, fmt.Errorf("failed to parse %s: %w", keyExportDenylist, err)
 	}
 	return allowList, denyList, nil
 }
 
+func getExportFieldMasks() ([]*fgs.EventFieldMask, error) {
+	masks, err := fieldmask.ParseFieldMaskList(viper.GetString(keyExportFieldMask))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", keyExportFieldMask, err)
+	}
+	return masks, nil
+}
+
 func saveInitInfo() error {
 	info := bugtool.InitInfo{
 		ExportFname: exportFilename,
@@ -165,13 +174,14 @@ func hubbleFGSExecute() error {
 	if err = Serve(ctx, serverAddress, pm.Server); err != nil {
 		return err
 	}
-	if exportFilename != "" {
-		if err = startExporter(ctx, pm.Server); err != nil {
-			return err
-		}
+	if err = startExporters(ctx, pm.Server); err != nil {
+		return err
+	}
+	if err = startNotifiers(ctx, pm.Server); err != nil {
+		return err
 	}
 
-	log.WithField("enabled", exportFilename != "").WithField("fileName", exportFilename).Info("Exporter configuration")
+	log.WithField("enabled", exportFilename != "").WithField("fileName", exportFilename).WithField("config", exportConfig).Info("Exporter configuration")
 	obs.AddListener(pm)
 	saveInitInfo()
 	if enableK8sAPI {
@@ -188,51 +198,114 @@ func getObserverDir() string {
 	return filepath.Join(bpf.GetMapRoot(), observerDir)
 }
 
-func startExporter(ctx context.Context, server *server.Server) error {
+// defaultExporterName is the name of the exporter configured by the export-*
+// flags.
+const defaultExporterName = "default"
+
+// getFlagsExporterConfig returns the configuration of the JSON file exporter
+// that is configured by the export-* flags.
+func getFlagsExporterConfig() (*exporter.Config, error) {
 	allowList, denyList, err := getExportFilters()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	writer := lumberjack.Logger{
-		Filename:   exportFilename,
-		MaxSize:    exportFileMaxSizeMB,
-		MaxBackups: exportFileMaxBackups,
-		Compress:   exportFileCompress,
-	}
-	if exportFileRotationInterval != 0 {
-		log.WithField("duration", exportFileRotationInterval).Info("Periodically rotating JSON export files")
-		go func() {
-			ticker := time.NewTicker(exportFileRotationInterval)
-			for {
-				select {
-				case <-ctx.Done():
-					return
-				case <-ticker.C:
-					if rotationErr := writer.Rotate(); rotationErr != nil {
-						log.WithError(rotationErr).
-							WithField("filename", exportFilename).
-							Warn("Failed to rotate JSON export file")
-					}
-				}
-			}
-		}()
+	fieldMasks, err := getExportFieldMasks()
+	if err != nil {
+		return nil, err
+	}
+	cfg := &exporter.Config{
+		Name: defaultExporterName,
+		Sink: exporter.SinkConfig{
+			Type:             exporter.SinkTypeFile,
+			Path:             exportFilename,
+			MaxSizeMB:        exportFileMaxSizeMB,
+			MaxBackups:       exportFileMaxBackups,
+			Compress:         exportFileCompress,
+			RotationInterval: metav1.Duration{Duration: exportFileRotationInterval},
+		},
+		AllowList:  allowList,
+		DenyList:   denyList,
+		FieldMask:  fieldMasks,
+		BufferSize: exportBufferSize,
+		DropPolicy: exportDropPolicy,
 	}
-	encoder := json.NewEncoder(&writer)
-	var rateLimiter *ratelimit.RateLimiter
 	if exportRateLimit >= 0 {
-		rateLimiter = ratelimit.NewRateLimiter(ctx, 1*time.Minute, exportRateLimit, encoder)
+		cfg.RateLimit = &exportRateLimit
+	}
+	if exportHashChainKey != "" {
+		cfg.Sink.HashChain = &exporter.HashChainConfig{
+			KeyFile:            exportHashChainKey,
+			CheckpointInterval: metav1.Duration{Duration: exportHashChainCheckpointInterval},
+		}
 	}
-	var aggregationOptions *fgs.AggregationOptions
 	if enableExportAggregation {
-		aggregationOptions = &fgs.AggregationOptions{
+		cfg.Aggregation = &fgs.AggregationOptions{
 			WindowSize:        durationpb.New(exportAggregationWindowSize),
 			ChannelBufferSize: exportAggregationBufferSize,
+			KeyFields:         exportAggregationKeyFields,
+		}
+		for _, arg := range exportAggregationKeyArgs {
+			if arg < 0 {
+				return nil, fmt.Errorf("invalid %s: negative argument index %d", keyExportAggregationKeyArgs, arg)
+			}
+			cfg.Aggregation.KeyArgs = append(cfg.Aggregation.KeyArgs, uint32(arg))
+		}
+	}
+	return cfg, nil
+}
+
+// getExporterConfigs returns the configurations of the exporter of the
+// export-* flags, if export-filename is set, and of the exporters of the
+// export-config file.
+func getExporterConfigs() ([]exporter.Config, error) {
+	var configs []exporter.Config
+	if exportFilename != "" {
+		cfg, err := getFlagsExporterConfig()
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, *cfg)
+	}
+	if exportConfig != "" {
+		fileConfigs, err := exporter.ReadConfigFile(exportConfig)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, fileConfigs...)
+	}
+	return configs, nil
+}
+
+func startExporters(ctx context.Context, server *server.Server) error {
+	configs, err := getExporterConfigs()
+	if err != nil {
+		return err
+	}
+	if len(configs) == 0 {
+		return nil
+	}
+	return exporter.StartExporters(ctx, server, configs)
+}
+
+// startNotifiers starts the webhooks of the notifier-config file. Each webhook
+// gets the events from its own listener, like exporters.
+func startNotifiers(ctx context.Context, server *server.Server) error {
+	if notifierConfig == "" {
+		return nil
+	}
+	configs, err := notifier.ReadConfigFile(notifierConfig)
+	if err != nil {
+		return err
+	}
+	for i := range configs {
+		cfg := &configs[i]
+		webhook, err := notifier.New(ctx, cfg)
+		if err != nil {
+			return fmt.Errorf("webhook %q: %w", cfg.Name, err)
 		}
+		log.WithField("webhook", cfg.Name).WithField("url", cfg.URL).Info("Starting webhook")
+		exporter.NewExporter(ctx, "webhook/"+cfg.Name, cfg.Request(), server, webhook, nil).Start()
 	}
-	req := fgs.GetEventsRequest{AllowList: allowList, DenyList: denyList, AggregationOptions: aggregationOptions}
-	log.WithFields(logrus.Fields{"logger": &writer, "request": &req}).Info("Starting JSON exporter")
-	exporter := exporter.NewExporter(ctx, &req, server, encoder, rateLimiter)
-	exporter.Start()
 	return nil
 }
 
@@ -318,13 +391,22 @@ func execute() error {
 	flags.String(keyKernelVersion, "", "Kernel version")
 	flags.Int(keyVerbosity, 0, "set verbosity level")
 	flags.Int(keyProcessCacheSize, 65536, "Size of the process cache")
+	flags.Int(keyEventHistorySize, 1000, "Number of recent events kept in memory to be replayed by GetEvents requests. Set to 0 to disable")
+	flags.Duration(keyEventHistoryMaxAge, 0, "Maximum age of the events kept in memory to be replayed. Set to 0 to only bound the history by its size")
+	flags.Int(keyPerfRingBufferSize, 65535, "Size in bytes of the per-CPU perf ring buffer. Increase it (e.g. to 262144) for policies capturing arguments with maxData")
 	flags.Bool(keyForceSmallProgs, false, "Force loading small programs, even in kernels with >= 5.3 versions")
 	flags.String(keyExportFilename, "", "Filename for JSON export. Disabled by default")
+	flags.String(keyExportConfig, "", "YAML file that configures additional exporters. Disabled by default")
+	flags.String(keyNotifierConfig, "", "YAML file that configures webhooks notified of events, such as enforcement actions. Disabled by default")
 	flags.Int(keyExportFileMaxSizeMB, 10, "Size in MB for rotating JSON export files")
 	flags.Duration(keyExportFileRotationInterval, 0, "Interval at which to rotate JSON export files in addition to rotating them by size")
 	flags.Int(keyExportFileMaxBackups, 5, "Number of rotated JSON export files to retain")
 	flags.Bool(keyExportFileCompress, false, "Compress rotated JSON export files")
 	flags.Int(keyExportRateLimit, -1, "Rate limit (per minute) for event export. Set to -1 to disable")
+	flags.Uint32(keyExportBufferSize, 100, "Number of events buffered for the exporter when it does not keep up")
+	flags.String(keyExportDropPolicy, "drop-oldest", "What to do when the export buffer is full. drop-oldest, drop-newest, or disconnect")
+	flags.String(keyExportHashChainKey, "", "Ed25519 private key file (PKCS #8 PEM) that signs a hash chain of the JSON export files, checked by \"tetra export verify\". Disabled by default")
+	flags.Duration(keyExportHashChainCheckpointInterval, chain.DefaultCheckpointInterval, "Interval at which the hash chain of the JSON export files is signed")
 	flags.String(keyLogLevel, "info", "Set log level")
 	flags.String(keyLogFormat, "text", "Set log format")
 	flags.Bool(keyEnableK8sAPI, false, "Access Kubernetes API to associate FGS events with Kubernetes pods")
@@ -335,6 +417,7 @@ func execute() error {
 	flags.String(keyCiliumBPF, "", "Cilium BPF directory")
 	flags.Bool(keyEnableProcessCred, false, "Enable process_cred events")
 	flags.Bool(keyEnableProcessNs, false, "Enable namespace information in process_exec and process_kprobe events")
+	flags.Bool(keyEnablePathResolution, false, "Complete file and path arguments truncated in BPF using procfs and report their host paths")
 
 	// Config files
 	flags.String(keyConfigFile, "", "Configuration file to load from")
@@ -350,10 +433,13 @@ func execute() error {
 	flags.Bool(keyEnableExportAggregation, false, "Enable JSON export aggregation")
 	flags.Duration(keyExportAggregationWindowSize, 15*time.Second, "JSON export aggregation time window")
 	flags.Uint64(keyExportAggregationBufferSize, 10000, "Aggregator channel buffer size")
+	flags.StringSlice(keyExportAggregationKeyFields, nil, "Fields of kprobe and tracepoint events that identify aggregated events, e.g. process.exec_id,function_name. Defaults to the process and the function")
+	flags.IntSlice(keyExportAggregationKeyArgs, nil, "Indexes of the kprobe and tracepoint arguments that are also part of the aggregation key")
 
 	// JSON export filter options
 	flags.String(keyExportAllowlist, "", "JSON export allowlist")
 	flags.String(keyExportDenylist, "", "JSON export denylist")
+	flags.String(keyExportFieldMask, "", "JSON export field masks, e.g. {\"event_set\": [\"PROCESS_EXEC\"], \"exclude\": \"parent,process.pod\"}")
 
 	// Network namespace options
 	flags.String(keyNetnsDir, "/var/run/docker/netns/", "Network namespace dir")
@@ -15,19 +15,35 @@
 package aggregator
 
 import (
+	"context"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/isovalent/tetragon-oss/api/v1/fgs"
 	"github.com/isovalent/tetragon-oss/pkg/logger"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// maxAggregationKeys is the maximum number of keys in the cache of an
+// aggregator. The cache is flushed before the end of the window when a new
+// key would exceed it.
+const maxAggregationKeys = 10000
+
 type Aggregator struct {
-	server fgs.FineGuidanceSensors_GetEventsServer
-	window time.Duration
-	events chan *fgs.GetEventsResponse
-	cache  map[string]*fgs.GetEventsResponse
+	server  fgs.FineGuidanceSensors_GetEventsServer
+	window  time.Duration
+	events  chan *fgs.GetEventsResponse
+	cache   map[string]*fgs.GetEventsResponse
+	keys    map[protoreflect.Name]*eventKey
+	maxKeys int
+}
+
+// CheckOptions returns an error if the aggregation options are invalid.
+func CheckOptions(options *fgs.AggregationOptions) error {
+	_, err := compileKeys(options)
+	return err
 }
 
 func NewAggregator(
@@ -41,30 +57,56 @@ func NewAggregator(
 	if options.WindowSize != nil {
 		window = options.WindowSize.AsDuration()
 	}
+	keys, err := compileKeys(options)
+	if err != nil {
+		return nil, err
+	}
 	return &Aggregator{
 		server,
 		window,
 		make(chan *fgs.GetEventsResponse, options.ChannelBufferSize),
 		make(map[string]*fgs.GetEventsResponse),
+		keys,
+		maxAggregationKeys,
 	}, nil
 }
 
-func (a *Aggregator) Start() {
-	// nolint Since Aggregator.Start is an endless function,
-	// this qualifies as an acceptable use of time.Tick
-	tick := time.Tick(a.window)
+// Start aggregates the events of the event channel until ctx is done. It then
+// sends the events that are still buffered or cached, so that they are not
+// lost when the request ends.
+func (a *Aggregator) Start(ctx context.Context) {
+	ticker := time.NewTicker(a.window)
+	defer ticker.Stop()
 	for {
 		select {
 		case event := <-a.events:
 			a.handleEvent(event)
-		case <-tick:
+		case <-ticker.C:
 			a.flush()
+		case <-ctx.Done():
+			for {
+				select {
+				case event := <-a.events:
+					a.handleEvent(event)
+				default:
+					a.flush()
+					return
+				}
+			}
 		}
 	}
 }
 
 func (a *Aggregator) flush() {
+	events := make([]*fgs.GetEventsResponse, 0, len(a.cache))
 	for _, event := range a.cache {
+		events = append(events, event)
+	}
+	// send the events in the order of their first occurrence
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Sequence < events[j].Sequence
+	})
+	for _, event := range events {
 		if err := a.server.Send(event); err != nil {
 			logger.GetLogger().WithError(err).Warn("Failed to send aggregated response")
 		}
@@ -73,13 +115,52 @@ func (a *Aggregator) flush() {
 	a.cache = make(map[string]*fgs.GetEventsResponse)
 }
 
+// eventTime returns the time of an event, or the current time if the event
+// has none.
+func eventTime(event *fgs.GetEventsResponse) *timestamppb.Timestamp {
+	if event.Time != nil {
+		return event.Time
+	}
+	return timestamppb.Now()
+}
+
+// newAggregatedResponse returns the response that aggregates the events with
+// the same key as event. Events are shared with the other listeners, so the
+// event itself is not modified.
+func newAggregatedResponse(event *fgs.GetEventsResponse) *fgs.GetEventsResponse {
+	m := event.ProtoReflect()
+	res := m.New()
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		res.Set(fd, v)
+		return true
+	})
+	ret := res.Interface().(*fgs.GetEventsResponse)
+	t := eventTime(event)
+	ret.AggregationInfo = &fgs.AggregationInfo{
+		Count:     1,
+		FirstTime: t,
+		LastTime:  t,
+	}
+	return ret
+}
+
 func (a *Aggregator) handleEvent(event *fgs.GetEventsResponse) {
-	switch event.Event.(type) {
-	default:
+	key, ok := aggregationKey(a.keys, event)
+	if !ok {
 		if err := a.server.Send(event); err != nil {
 			logger.GetLogger().WithError(err).Warn("Failed to send unaggregated response")
 		}
+		return
+	}
+	if cached, ok := a.cache[key]; ok {
+		cached.AggregationInfo.Count++
+		cached.AggregationInfo.LastTime = eventTime(event)
+		return
+	}
+	if len(a.cache) >= a.maxKeys {
+		a.flush()
 	}
+	a.cache[key] = newAggregatedResponse(event)
 }
 
 func getNameOrIp(ip string, names []string) string {
@@ -15,12 +15,160 @@
 package aggregator
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func Test_getNameOrIp(t *testing.T) {
 	assert.Equal(t, "1.1.1.1", getNameOrIp("1.1.1.1", []string{}))
 	assert.Equal(t, "a.com,b.com,c.com", getNameOrIp("1.1.1.1", []string{"b.com", "c.com", "a.com"}))
 }
+
+type fakeServer struct {
+	grpc.ServerStream
+	sent []*fgs.GetEventsResponse
+}
+
+func (f *fakeServer) Send(res *fgs.GetEventsResponse) error {
+	f.sent = append(f.sent, res)
+	return nil
+}
+
+func kprobeResponse(seq uint64, execID, function string, args ...*fgs.KprobeArgument) *fgs.GetEventsResponse {
+	return &fgs.GetEventsResponse{
+		Event: &fgs.GetEventsResponse_ProcessKprobe{
+			ProcessKprobe: &fgs.ProcessKprobe{
+				Process:      &fgs.Process{ExecId: execID},
+				FunctionName: function,
+				Args:         args,
+			},
+		},
+		Time:     timestamppb.New(time.Unix(int64(seq), 0)),
+		Sequence: seq,
+	}
+}
+
+func stringArg(s string) *fgs.KprobeArgument {
+	return &fgs.KprobeArgument{Arg: &fgs.KprobeArgument_StringArg{StringArg: s}}
+}
+
+func TestAggregator(t *testing.T) {
+	server := &fakeServer{}
+	a, err := NewAggregator(server, &fgs.AggregationOptions{})
+	require.NoError(t, err)
+
+	first := kprobeResponse(1, "a", "fd_install")
+	a.handleEvent(first)
+	a.handleEvent(kprobeResponse(2, "b", "fd_install"))
+	a.handleEvent(kprobeResponse(3, "a", "fd_install"))
+	a.handleEvent(kprobeResponse(4, "a", "security_file_open"))
+	a.handleEvent(kprobeResponse(5, "a", "fd_install"))
+	exec := &fgs.GetEventsResponse{Event: &fgs.GetEventsResponse_ProcessExec{}}
+	a.handleEvent(exec)
+	// events that are not aggregated are sent right away
+	assert.Equal(t, []*fgs.GetEventsResponse{exec}, server.sent)
+
+	a.flush()
+	require.Len(t, server.sent, 4)
+	for i, want := range []struct {
+		execID, function string
+		count, first     uint64
+		last             uint64
+	}{
+		{"a", "fd_install", 3, 1, 5},
+		{"b", "fd_install", 1, 2, 2},
+		{"a", "security_file_open", 1, 4, 4},
+	} {
+		res := server.sent[i+1]
+		assert.Equal(t, want.execID, res.GetProcessKprobe().Process.ExecId)
+		assert.Equal(t, want.function, res.GetProcessKprobe().FunctionName)
+		assert.Equal(t, want.count, res.AggregationInfo.Count)
+		assert.Equal(t, int64(want.first), res.AggregationInfo.FirstTime.Seconds)
+		assert.Equal(t, int64(want.last), res.AggregationInfo.LastTime.Seconds)
+	}
+	// the original events are not modified
+	assert.Nil(t, first.AggregationInfo)
+}
+
+func TestAggregatorKeys(t *testing.T) {
+	server := &fakeServer{}
+	a, err := NewAggregator(server, &fgs.AggregationOptions{
+		KeyFields: []string{"function_name"},
+		KeyArgs:   []uint32{1},
+	})
+	require.NoError(t, err)
+
+	a.handleEvent(kprobeResponse(1, "a", "f", stringArg("x"), stringArg("y")))
+	a.handleEvent(kprobeResponse(2, "b", "f", stringArg("z"), stringArg("y")))
+	a.handleEvent(kprobeResponse(3, "a", "f", stringArg("x"), stringArg("w")))
+	a.handleEvent(kprobeResponse(4, "a", "f", stringArg("x")))
+	a.flush()
+	require.Len(t, server.sent, 3)
+	assert.Equal(t, uint64(2), server.sent[0].AggregationInfo.Count)
+	assert.Equal(t, uint64(1), server.sent[1].AggregationInfo.Count)
+	assert.Equal(t, uint64(1), server.sent[2].AggregationInfo.Count)
+}
+
+func TestAggregatorMaxKeys(t *testing.T) {
+	server := &fakeServer{}
+	a, err := NewAggregator(server, &fgs.AggregationOptions{})
+	require.NoError(t, err)
+	a.maxKeys = 2
+
+	a.handleEvent(kprobeResponse(1, "a", "f"))
+	a.handleEvent(kprobeResponse(2, "b", "f"))
+	a.handleEvent(kprobeResponse(3, "a", "f"))
+	assert.Empty(t, server.sent)
+
+	// a new key flushes the cache when it is full
+	a.handleEvent(kprobeResponse(4, "c", "f"))
+	require.Len(t, server.sent, 2)
+	assert.Equal(t, uint64(2), server.sent[0].AggregationInfo.Count)
+	assert.Equal(t, uint64(1), server.sent[1].AggregationInfo.Count)
+	assert.Len(t, a.cache, 1)
+
+	a.flush()
+	require.Len(t, server.sent, 3)
+	assert.Equal(t, "c", server.sent[2].GetProcessKprobe().Process.ExecId)
+}
+
+func TestCheckOptions(t *testing.T) {
+	assert.NoError(t, CheckOptions(&fgs.AggregationOptions{KeyFields: []string{"process.pod.name", "subsys"}}))
+	assert.Error(t, CheckOptions(&fgs.AggregationOptions{KeyFields: []string{"process.foo"}}))
+	assert.Error(t, CheckOptions(&fgs.AggregationOptions{KeyFields: []string{"args.string_arg"}}))
+}
+
+func TestAggregatorStart(t *testing.T) {
+	server := &fakeServer{}
+	a, err := NewAggregator(server, &fgs.AggregationOptions{ChannelBufferSize: 10})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		a.Start(ctx)
+	}()
+	for i := uint64(1); i <= 3; i++ {
+		a.GetEventChannel() <- kprobeResponse(i, "a", "f")
+	}
+	a.GetEventChannel() <- kprobeResponse(4, "b", "f")
+
+	// the events are sent when the context is done, before the window ends
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("aggregator did not stop")
+	}
+	require.Len(t, server.sent, 2)
+	assert.Equal(t, uint64(3), server.sent[0].AggregationInfo.Count)
+	assert.Equal(t, uint64(1), server.sent[1].AggregationInfo.Count)
+}
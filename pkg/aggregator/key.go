@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package aggregator
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const eventOneof = "event"
+
+var (
+	kprobeField     = protoreflect.Name("process_kprobe")
+	tracepointField = protoreflect.Name("process_tracepoint")
+
+	// defaultKeyFields are the key fields of the event types that are
+	// aggregated, when the request does not set them.
+	defaultKeyFields = map[protoreflect.Name][]string{
+		kprobeField:     {"process.exec_id", "function_name"},
+		tracepointField: {"process.exec_id", "subsys", "event"},
+	}
+
+	marshalOptions = proto.MarshalOptions{Deterministic: true}
+)
+
+// keyField is a field path resolved against an event message.
+type keyField []protoreflect.FieldDescriptor
+
+func compileKeyField(md protoreflect.MessageDescriptor, path string) (keyField, error) {
+	names := strings.Split(path, ".")
+	var kf keyField
+	for i, name := range names {
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return nil, fmt.Errorf("%s has no field %q", md.FullName(), name)
+		}
+		if i < len(names)-1 && (fd.Message() == nil || fd.IsList() || fd.IsMap()) {
+			return nil, fmt.Errorf("field %q of %s is not a message", name, md.FullName())
+		}
+		kf = append(kf, fd)
+		md = fd.Message()
+	}
+	return kf, nil
+}
+
+func appendUvarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	return append(b, buf[:n]...)
+}
+
+// appendKey appends the length-prefixed encoding of a field to key. The field
+// is encoded as a message of its parent type with only this field set, so
+// that any kind of field can be encoded.
+func appendKey(key []byte, m protoreflect.Message, fd protoreflect.FieldDescriptor) []byte {
+	tmp := m.New()
+	if m.Has(fd) {
+		tmp.Set(fd, m.Get(fd))
+	}
+	b, err := marshalOptions.Marshal(tmp.Interface())
+	if err != nil {
+		// should not happen, but keep such events apart
+		b = []byte(err.Error())
+	}
+	key = appendUvarint(key, uint64(len(b)))
+	return append(key, b...)
+}
+
+func (kf keyField) appendKey(key []byte, m protoreflect.Message) []byte {
+	for _, fd := range kf[:len(kf)-1] {
+		m = m.Get(fd).Message()
+	}
+	return appendKey(key, m, kf[len(kf)-1])
+}
+
+// eventKey computes the aggregation key of an event type.
+type eventKey struct {
+	fields []keyField
+	args   []uint32
+}
+
+func (ek *eventKey) key(event protoreflect.Message, args []*fgs.KprobeArgument) string {
+	var key []byte
+	for _, kf := range ek.fields {
+		key = kf.appendKey(key, event)
+	}
+	for _, i := range ek.args {
+		if int(i) >= len(args) {
+			key = appendUvarint(key, 0)
+			continue
+		}
+		b, _ := marshalOptions.Marshal(args[i])
+		key = appendUvarint(key, uint64(len(b)+1))
+		key = append(key, b...)
+	}
+	return string(key)
+}
+
+// compileKeys builds the aggregation keys of the aggregated event types.
+func compileKeys(options *fgs.AggregationOptions) (map[protoreflect.Name]*eventKey, error) {
+	oneof := (&fgs.GetEventsResponse{}).ProtoReflect().Descriptor().Oneofs().ByName(eventOneof)
+	found := map[string]bool{}
+	keys := map[protoreflect.Name]*eventKey{}
+	for name, defaults := range defaultKeyFields {
+		md := oneof.Fields().ByName(name).Message()
+		ek := &eventKey{args: options.GetKeyArgs()}
+		paths := options.GetKeyFields()
+		if len(paths) == 0 {
+			paths = defaults
+		}
+		for _, path := range paths {
+			kf, err := compileKeyField(md, path)
+			if err != nil {
+				continue
+			}
+			found[path] = true
+			ek.fields = append(ek.fields, kf)
+		}
+		keys[name] = ek
+	}
+	for _, path := range options.GetKeyFields() {
+		if !found[path] {
+			return nil, fmt.Errorf("invalid key field %q: no such field in kprobe and tracepoint events", path)
+		}
+	}
+	return keys, nil
+}
+
+// aggregationKey returns the aggregation key of the response, or false if
+// the response is not aggregated.
+func aggregationKey(keys map[protoreflect.Name]*eventKey, response *fgs.GetEventsResponse) (string, bool) {
+	var args []*fgs.KprobeArgument
+	switch ev := response.Event.(type) {
+	case *fgs.GetEventsResponse_ProcessKprobe:
+		args = ev.ProcessKprobe.GetArgs()
+	case *fgs.GetEventsResponse_ProcessTracepoint:
+		args = ev.ProcessTracepoint.GetArgs()
+	default:
+		return "", false
+	}
+	m := response.ProtoReflect()
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName(eventOneof))
+	ek, ok := keys[fd.Name()]
+	if !ok {
+		return "", false
+	}
+	return string(fd.Name()) + ":" + ek.key(m.Get(fd).Message(), args), true
+}
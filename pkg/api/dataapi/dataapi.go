@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+package dataapi
+
+import "github.com/isovalent/tetragon-oss/pkg/api/processapi"
+
+const (
+	// MsgDataArgLen is the max payload size of a single data event
+	MsgDataArgLen = 8192
+	// MaxDataSize is the max value of the maxData argument option
+	MaxDataSize = 8 * MsgDataArgLen
+)
+
+type DataEventId struct {
+	Pid  uint64 `align:"pid"`
+	Time uint64 `align:"time"`
+}
+
+type DataEventDesc struct {
+	Error    int32       `align:"error"`
+	Pad      uint32      `align:"pad"`
+	Leftover uint32      `align:"leftover"`
+	Size     uint32      `align:"size"`
+	Id       DataEventId `align:"id"`
+}
+
+type MsgData struct {
+	Common processapi.MsgCommon `align:"common"`
+	Id     DataEventId          `align:"id"`
+}
@@ -0,0 +1,13 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+package lostapi
+
+// MsgLostEvents is sent to the observer listeners when events were lost in
+// the perf ring of a CPU, or could not be decoded.
+type MsgLostEvents struct {
+	Cpu int
+	// Sensor is the name of the sensor whose events were lost, empty
+	// when it is not known.
+	Sensor string
+	Count  uint64
+}
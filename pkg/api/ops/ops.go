@@ -16,6 +16,10 @@ const (
 	MSG_OP_GENERIC_KPROBE     = 13
 	MSG_OP_GENERIC_TRACEPOINT = 14
 
+	// MSG_OP_DATA carries a chunk of an argument payload that is
+	// referenced by a later generic kprobe event.
+	MSG_OP_DATA = 15
+
 	// just for testing
 	MSG_OP_TEST = 254
 )
@@ -29,6 +33,7 @@ const (
 	MsgOpKfreeSkb           = 11
 	MsgOpGenericKprobe      = 13
 	MsgOpGeneric_Tracepoint = 14
+	MsgOpData               = 15
 	MsgOpTest               = 254
 )
 
@@ -39,6 +44,7 @@ func (op OpCode) String() string {
 		7:   "Exit",
 		13:  "GenericKprobe",
 		14:  "GenericTracepoint",
+		15:  "Data",
 		254: "Test",
 	}[op]
 }
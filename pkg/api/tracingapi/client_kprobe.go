@@ -33,6 +33,10 @@ type MsgGenericKprobeArgPath struct {
 	Index uint64
 	Value string
 	Flags uint32
+	// ResolvedPath and HostPath are set when the path is resolved in
+	// userspace (see option.Config.EnablePathResolution)
+	ResolvedPath string
+	HostPath     string
 }
 
 func (m MsgGenericKprobeArgPath) GetIndex() uint64 {
@@ -47,6 +51,12 @@ type MsgGenericKprobeArgFile struct {
 	Index uint64
 	Value string
 	Flags uint32
+	// Fd is the file descriptor of the argument, or -1 if not known
+	Fd int32
+	// ResolvedPath and HostPath are set when the path is resolved in
+	// userspace (see option.Config.EnablePathResolution)
+	ResolvedPath string
+	HostPath     string
 }
 
 func (m MsgGenericKprobeArgFile) GetIndex() uint64 {
@@ -185,6 +195,16 @@ type MsgGenericKprobeCred struct {
 	Permitted   uint64
 	Effective   uint64
 	Inheritable uint64
+	Uid         uint32
+	Gid         uint32
+	Suid        uint32
+	Sgid        uint32
+	Euid        uint32
+	Egid        uint32
+	FSuid       uint32
+	FSgid       uint32
+	SecureBits  uint32
+	Pad         uint32
 }
 
 type MsgGenericKprobeArgCred struct {
@@ -192,6 +212,15 @@ type MsgGenericKprobeArgCred struct {
 	Permitted   uint64
 	Effective   uint64
 	Inheritable uint64
+	Uid         uint32
+	Gid         uint32
+	Suid        uint32
+	Sgid        uint32
+	Euid        uint32
+	Egid        uint32
+	FSuid       uint32
+	FSgid       uint32
+	SecureBits  uint32
 }
 
 func (m MsgGenericKprobeArgCred) GetIndex() uint64 {
@@ -202,6 +231,69 @@ func (m MsgGenericKprobeArgCred) IsReturnArg() bool {
 	return m.Index == ReturnArgIndex
 }
 
+const BpfObjNameLen = 16
+
+type MsgGenericKprobeBpfAttr struct {
+	ProgType uint32
+	InsnCnt  uint32
+	ProgName [BpfObjNameLen]byte
+}
+
+type MsgGenericKprobeArgBpfAttr struct {
+	Index    uint64
+	ProgType uint32
+	InsnCnt  uint32
+	ProgName string
+}
+
+func (m MsgGenericKprobeArgBpfAttr) GetIndex() uint64 {
+	return m.Index
+}
+
+func (m MsgGenericKprobeArgBpfAttr) IsReturnArg() bool {
+	return m.Index == ReturnArgIndex
+}
+
+type MsgGenericKprobeArgModule struct {
+	Index uint64
+	Name  string
+}
+
+func (m MsgGenericKprobeArgModule) GetIndex() uint64 {
+	return m.Index
+}
+
+func (m MsgGenericKprobeArgModule) IsReturnArg() bool {
+	return m.Index == ReturnArgIndex
+}
+
+type MsgGenericKprobeArgLinuxBinprm struct {
+	Index       uint64
+	Path        string
+	Interpreter string
+}
+
+func (m MsgGenericKprobeArgLinuxBinprm) GetIndex() uint64 {
+	return m.Index
+}
+
+func (m MsgGenericKprobeArgLinuxBinprm) IsReturnArg() bool {
+	return m.Index == ReturnArgIndex
+}
+
+type MsgGenericKprobeArgCapability struct {
+	Index uint64
+	Value int32
+}
+
+func (m MsgGenericKprobeArgCapability) GetIndex() uint64 {
+	return m.Index
+}
+
+func (m MsgGenericKprobeArgCapability) IsReturnArg() bool {
+	return m.Index == ReturnArgIndex
+}
+
 type MsgGenericKprobeArg interface {
 	GetIndex() uint64
 	IsReturnArg() bool
@@ -215,6 +307,8 @@ type MsgGenericKprobeUnix struct {
 	Id           uint64
 	Action       uint64
 	FuncName     string
+	PolicyName   string
+	Message      string
 	Args         []MsgGenericKprobeArg
 }
 
@@ -23,5 +23,7 @@ type MsgGenericTracepointUnix struct {
 	Id         int64
 	Subsys     string
 	Event      string
+	PolicyName string
+	Message    string
 	Args       []MsgGenericTracepointArg
 }
@@ -38,11 +38,17 @@ static int __quiet(enum libbpf_print_level level __attribute__((unused)),
 {
 }
 
-void bpf_loader_programs(struct bpf_object *obj, int type, int verbosity) {
+void bpf_loader_programs(struct bpf_object *obj, int type, const char *attach_target, int verbosity) {
 	struct bpf_program *prog_bpf;
 
 	bpf_object__for_each_program(prog_bpf, obj) {
 		bpf_program__set_type(prog_bpf, type);
+		// BTF-enabled tracepoints: all programs (including the tail
+		// calls) need to have the same attach type and target
+		if (type == BPF_PROG_TYPE_TRACING && attach_target) {
+			bpf_program__set_expected_attach_type(prog_bpf, BPF_TRACE_RAW_TP);
+			bpf_program__set_attach_target(prog_bpf, 0, attach_target);
+		}
 		if (verbosity)
 			fprintf(stderr,
 				"program: kern_version: %u\n",
@@ -119,6 +125,7 @@ static struct bpf_object *__loader(const int version,
 		    const char *prog,
 		    const char *mapdir,
 		    const char *ciliumdir,
+		    const char *attach_target,
 		    const int type)
 {
 	struct bpf_object_load_attr attr = {0};
@@ -138,7 +145,7 @@ static struct bpf_object *__loader(const int version,
 		return NULL;
 	}
 
-	bpf_loader_programs(obj, type, verbosity);
+	bpf_loader_programs(obj, type, attach_target, verbosity);
 	err = bpf_loader_set_map(obj, mapdir, ciliumdir, verbosity);
 	if (err) {
 		fprintf(stderr, "bpf_loader_set_map failed %d\n", err);
@@ -235,7 +242,7 @@ int tracepoint_loader(const int version,
 	struct bpf_object *obj;
 	int err;
 
-	obj = __loader(version, verbosity, false, btf, prog, mapdir, 0, BPF_PROG_TYPE_TRACEPOINT);
+	obj = __loader(version, verbosity, false, btf, prog, mapdir, 0, 0, BPF_PROG_TYPE_TRACEPOINT);
 	if (!obj)
 		return -1;
 
@@ -357,7 +364,8 @@ void *generic_loader_args(
 	char *filter_map = "filter_map";
 	char *fdinstall_map = "fdinstall_map";
 
-	obj = __loader(version, verbosity, override, btf, prog, mapdir, 0, type);
+	obj = __loader(version, verbosity, override, btf, prog, mapdir, 0,
+		       type == BPF_PROG_TYPE_TRACING ? attach : 0, type);
 	if (!obj)
 		goto err;
 
@@ -393,6 +401,8 @@ void *generic_loader_args(
 			break;
 
 		case BPF_PROG_TYPE_TRACEPOINT:
+		case BPF_PROG_TYPE_RAW_TRACEPOINT:
+		case BPF_PROG_TYPE_TRACING:
 			snprintf(map_name, sizeof(map_name), "%s-tp-calls", __prog);
 			map_bpf = bpf_object__find_map_by_name(obj, "tp_calls");
 			break;
@@ -510,7 +520,7 @@ int generic_kprobe_ret_loader(const int version,
 		  const char *genmapdir)
 {
 	struct bpf_object *obj;
-	obj = __loader(version, verbosity, false, btf, prog, mapdir, genmapdir, BPF_PROG_TYPE_KPROBE);
+	obj = __loader(version, verbosity, false, btf, prog, mapdir, genmapdir, 0, BPF_PROG_TYPE_KPROBE);
 	if (!obj)
 		return -1;
 
@@ -538,6 +548,72 @@ int tracepoint_loader_args(const int version,
 	return __tracepoint_loader(obj, verbosity, btf, prog, attach_category, attach, label, __prog, mapdir);
 }
 
+int __raw_tracepoint_loader(struct bpf_object *obj,
+		      const int verbosity,
+		      const char *attach,
+		      const char *label,
+		      const char *__prog,
+		      const bool btf_tp)
+{
+	struct bpf_program *prog_bpf;
+	struct bpf_link *prog_attach;
+	int err;
+
+	prog_bpf = bpf_object__find_program_by_title(obj, label);
+	if (!prog_bpf) {
+		fprintf(stderr, "bpf_object__find_program_by_title(raw_tracepoint:%s): null pointer\n", label);
+		bpf_object__close(obj);
+		return -1;
+	}
+
+	bpf_program__unpin(prog_bpf, __prog);
+
+	if (btf_tp)
+		prog_attach = bpf_program__attach_trace(prog_bpf);
+	else
+		prog_attach = bpf_program__attach_raw_tracepoint(prog_bpf, attach);
+	err = libbpf_get_error(prog_attach);
+	if (err) {
+		if (verbosity)
+			fprintf(stderr, "bpf_program__attach_%s: failed (%s)\n",
+				btf_tp ? "trace" : "raw_tracepoint", attach);
+		goto out_object;
+	}
+
+	err = bpf_program__pin(prog_bpf, __prog);
+	if (err < 0) {
+		fprintf(stderr, "bpf_program__pin: failed %i\n", err);
+		goto out_object;
+	}
+	bpf_object__close(obj);
+	bpf_program__unload(prog_bpf);
+	return bpf_link_fd(prog_attach);
+out_object:
+	bpf_program__unload(prog_bpf);
+	bpf_object__close(obj);
+	return err;
+}
+
+int raw_tracepoint_loader_args(const int version,
+		  const int verbosity,
+		  void *btf,
+		  const char *prog,
+		  const char *attach,
+		  const char *label,
+		  const char *__prog,
+		  const char *mapdir,
+		  const bool btf_tp,
+		  void *filters) {
+	struct bpf_object *obj;
+	int type = btf_tp ? BPF_PROG_TYPE_TRACING : BPF_PROG_TYPE_RAW_TRACEPOINT;
+
+	obj = generic_loader_args(version, verbosity, false, btf, prog, attach, label,
+				  __prog, mapdir, filters, type);
+	if (!obj)
+		return -1;
+	return __raw_tracepoint_loader(obj, verbosity, attach, label, __prog, btf_tp);
+}
+
 int kprobe_loader(const int version,
 		  const int verbosity,
 		  void *btf,
@@ -549,7 +625,7 @@ int kprobe_loader(const int version,
 		  const bool retprobe)
 {
 	struct bpf_object *obj;
-	obj = __loader(version, verbosity, false, btf, prog, mapdir, 0, BPF_PROG_TYPE_KPROBE);
+	obj = __loader(version, verbosity, false, btf, prog, mapdir, 0, 0, BPF_PROG_TYPE_KPROBE);
 	if (!obj)
 		return -1;
 
@@ -675,6 +751,32 @@ func LoadTracepointArgsProgram(__version, __verbosity int,
 	return loaderInt, nil
 }
 
+// LoadRawTracepointArgsProgram loads a generic raw_tracepoint program, or a
+// tp_btf program if btfTp is set. attach is the tracepoint name (without the
+// category).
+func LoadRawTracepointArgsProgram(__version, __verbosity int,
+	btf uintptr,
+	object, attach, __label, __prog, __mapdir string,
+	btfTp bool,
+	filters [4096]byte) (int, error) {
+	version := C.int(__version)
+	verbosity := C.int(__verbosity)
+	o := C.CString(object)
+	a := C.CString(attach)
+	l := C.CString(__label)
+	p := C.CString(__prog)
+	mapdir := C.CString(__mapdir)
+	loader_fd := C.raw_tracepoint_loader_args(version,
+		verbosity,
+		unsafe.Pointer(btf),
+		o, a, l, p, mapdir, C.bool(btfTp), unsafe.Pointer(&filters))
+	loaderInt := int(loader_fd)
+	if loaderInt < 0 {
+		return 0, fmt.Errorf("Unable to raw tracepoint load: %d %s", loaderInt, object)
+	}
+	return loaderInt, nil
+}
+
 func QdiscTCInsert(linkName string, ingress bool) error {
 	link, err := netlink.LinkByName(linkName)
 	if err != nil {
@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package btf
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/isovalent/tetragon-oss/pkg/bpf"
+
+	gt "github.com/isovalent/tetragon-oss/pkg/generictypes"
+)
+
+// TracepointArgInfo is the name and the type of a raw (or BTF) tracepoint
+// argument as found in the kernel BTF.
+type TracepointArgInfo struct {
+	Name string
+	// Type is the C representation of the declared type
+	Type string
+	// ResolvedType is the C representation of the type after resolving
+	// typedefs and modifiers
+	ResolvedType string
+	Kind         bpf.BtfKind
+	Size         uint32
+}
+
+// resolveType follows typedefs and type modifiers until a concrete type is
+// found. Pointers are not followed.
+func resolveType(btf bpf.BTF, id bpf.BtfID) (bpf.BtfID, bpf.BtfType, error) {
+	for {
+		ty, err := btf.TypeByID(id)
+		if err != nil {
+			return id, ty, err
+		}
+		switch ty.Kind() {
+		case bpf.BtfKindTypdef, bpf.BtfKindConst, bpf.BtfKindVolatile, bpf.BtfKindRestrict:
+			id, err = btf.UnderlyingType(ty)
+			if err != nil {
+				return id, ty, err
+			}
+		default:
+			return id, ty, nil
+		}
+	}
+}
+
+// GetTracepointArgs returns the arguments of a tracepoint based on the
+// btf_trace_<event> typedef that the kernel defines for every tracepoint.
+//
+// The typedef is a pointer to a function prototype whose first argument is
+// the tracepoint private data (void *__data). This argument is not passed to
+// raw_tracepoint and tp_btf programs, so it is not included in the result.
+func GetTracepointArgs(btf bpf.BTF, event string) ([]TracepointArgInfo, error) {
+	name := fmt.Sprintf("btf_trace_%s", event)
+	tdefID, err := btf.FindByNameKind(name, bpf.BtfKindTypdef)
+	if err != nil {
+		return nil, fmt.Errorf("tracepoint %s not found in BTF: %w", event, err)
+	}
+	tdefTy, err := btf.TypeByID(tdefID)
+	if err != nil {
+		return nil, err
+	}
+	ptrID, err := btf.UnderlyingType(tdefTy)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	ptrTy, err := btf.TypeByID(ptrID)
+	if err != nil {
+		return nil, err
+	}
+	if ptrTy.Kind() != bpf.BtfKindPtr {
+		return nil, fmt.Errorf("%s is not a pointer (kind=%d)", name, ptrTy.Kind())
+	}
+	protoID, err := btf.UnderlyingType(ptrTy)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	protoTy, err := btf.TypeByID(protoID)
+	if err != nil {
+		return nil, err
+	}
+	if protoTy.Kind() != bpf.BtfKindFuncProto {
+		return nil, fmt.Errorf("%s is not a pointer to a function prototype (kind=%d)", name, protoTy.Kind())
+	}
+
+	nargs := int(protoTy.Vlen())
+	ret := make([]TracepointArgInfo, 0, nargs)
+	for i := 1; i < nargs; i++ {
+		argName, err := btf.ParamName(protoTy, i)
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to get name of param %d: %w", name, i, err)
+		}
+		argID, err := btf.ParamTypeID(protoTy, i)
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to get type of param %d: %w", name, i, err)
+		}
+		argTyStr, err := btf.DumpTy(argID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to dump type of param %d: %w", name, i, err)
+		}
+		resID, resTy, err := resolveType(btf, argID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to resolve type of param %d: %w", name, i, err)
+		}
+		resTyStr, err := btf.DumpTy(resID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to dump type of param %d: %w", name, i, err)
+		}
+		ret = append(ret, TracepointArgInfo{
+			Name:         argName,
+			Type:         argTyStr,
+			ResolvedType: resTyStr,
+			Kind:         resTy.Kind(),
+			Size:         resTy.Size(),
+		})
+	}
+
+	return ret, nil
+}
+
+// GenericTypeId returns the generic type id that best matches the argument
+// type. If the type is not supported, it returns GenericInvalidType and an
+// error.
+func (arg *TracepointArgInfo) GenericTypeId() (int, error) {
+	switch arg.Kind {
+	case bpf.BtfKindInteger:
+		unsigned := strings.Contains(arg.ResolvedType, "unsigned") || arg.ResolvedType == "_Bool"
+		switch arg.Size {
+		case 1, 2, 4:
+			if unsigned {
+				return gt.GenericU32Type, nil
+			}
+			return gt.GenericS32Type, nil
+		case 8:
+			if arg.Type == "size_t" {
+				return gt.GenericSizeType, nil
+			}
+			if unsigned {
+				return gt.GenericU64Type, nil
+			}
+			return gt.GenericS64Type, nil
+		}
+	case bpf.BtfKindEnum:
+		return gt.GenericS32Type, nil
+	case bpf.BtfKindPtr:
+		ty := strings.TrimPrefix(arg.ResolvedType, "const ")
+		switch ty {
+		case "struct sk_buff *":
+			return gt.GenericSkbType, nil
+		case "struct sock *":
+			return gt.GenericSockType, nil
+		case "struct file *":
+			return gt.GenericFileType, nil
+		case "struct path *":
+			return gt.GenericPathType, nil
+		case "struct cred *":
+			return gt.GenericCredType, nil
+		case "struct filename *":
+			return gt.GenericFilenameType, nil
+		case "union bpf_attr *":
+			return gt.GenericBpfAttr, nil
+		case "struct module *":
+			return gt.GenericModuleType, nil
+		case "struct linux_binprm *":
+			return gt.GenericLinuxBinprm, nil
+		case "char *":
+			return gt.GenericStringType, nil
+		}
+		// report other pointers as their value
+		return gt.GenericU64Type, nil
+	}
+
+	return gt.GenericInvalidType, fmt.Errorf("unsupported type for argument %s: %s", arg.Name, arg.Type)
+}
@@ -196,6 +196,36 @@ func typesCompatible(specTy string, kernelTy string) bool {
 		case "struct file *":
 			return true
 		}
+	case "cred":
+		switch kernelTy {
+		case "const struct cred *", "struct cred *":
+			return true
+		}
+	case "bpf_attr":
+		switch kernelTy {
+		case "union bpf_attr *":
+			return true
+		}
+	case "module":
+		switch kernelTy {
+		case "struct module *":
+			return true
+		}
+	case "load_info":
+		switch kernelTy {
+		case "struct load_info *":
+			return true
+		}
+	case "linux_binprm":
+		switch kernelTy {
+		case "struct linux_binprm *":
+			return true
+		}
+	case "capability":
+		switch kernelTy {
+		case "int":
+			return true
+		}
 	}
 
 	return false
@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package encoder
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+)
+
+// ECSVersion is the version of the Elastic Common Schema of ECSEncoder.
+const ECSVersion = "8.6.0"
+
+// ECSEncoder encodes fgs.GetEventsResponse as Elastic Common Schema
+// documents, one JSON object per line. It maps process exec, exit, kprobe and
+// dns events, and skips the others.
+type ECSEncoder struct {
+	encoder *json.Encoder
+}
+
+// NewECSEncoder initializes and returns a pointer to ECSEncoder.
+func NewECSEncoder(w io.Writer) *ECSEncoder {
+	return &ECSEncoder{encoder: json.NewEncoder(w)}
+}
+
+// Encode implements EventEncoder.Encode.
+func (e *ECSEncoder) Encode(v interface{}) error {
+	event, ok := v.(*fgs.GetEventsResponse)
+	if !ok {
+		return fmt.Errorf("invalid event")
+	}
+	doc, err := ecsDocument(event)
+	if err != nil || doc == nil {
+		return err
+	}
+	return e.encoder.Encode(doc)
+}
+
+// ecsProcess sets the process fields at prefix, process or process.parent.
+func ecsProcess(doc document, prefix string, p *fgs.Process) {
+	if p == nil {
+		return
+	}
+	doc.set(prefix+".entity_id", p.ExecId)
+	if p.Pid != nil {
+		doc.set(prefix+".pid", p.Pid.Value)
+	}
+	doc.set(prefix+".executable", p.Binary)
+	doc.set(prefix+".name", baseName(p.Binary))
+	if args := processArgs(p); len(args) > 0 {
+		doc.set(prefix+".args", args)
+		doc.set(prefix+".args_count", len(args))
+		doc.set(prefix+".command_line", commandLine(p))
+	}
+	doc.set(prefix+".working_directory", p.Cwd)
+	if validTime(p.StartTime) {
+		doc.set(prefix+".start", p.StartTime.AsTime().Format(time.RFC3339Nano))
+	}
+	if p.Uid != nil {
+		doc.set(prefix+".user.id", strconv.FormatUint(uint64(p.Uid.Value), 10))
+	}
+}
+
+// ecsPod sets the container and orchestrator fields of the pod of a process.
+func ecsPod(doc document, pod *fgs.Pod) {
+	if pod == nil {
+		return
+	}
+	doc.set("orchestrator.type", "kubernetes")
+	doc.set("orchestrator.namespace", pod.Namespace)
+	doc.set("orchestrator.resource.type", "pod")
+	doc.set("orchestrator.resource.name", pod.Name)
+	doc.set("orchestrator.resource.label", pod.Labels)
+	if c := pod.Container; c != nil {
+		runtime, id := splitContainerID(c.Id)
+		doc.set("container.runtime", runtime)
+		doc.set("container.id", id)
+		doc.set("container.name", c.Name)
+		if c.Image != nil {
+			doc.set("container.image.name", c.Image.Name)
+		}
+	}
+}
+
+// ecsConnection sets the source, destination and network fields of a
+// connection.
+func ecsConnection(doc document, c *connection) {
+	doc.set("source.ip", c.saddr)
+	doc.set("source.port", c.sport)
+	doc.set("destination.ip", c.daddr)
+	doc.set("destination.port", c.dport)
+	doc.set("network.transport", c.transport)
+	doc.set("network.type", c.ipVersion())
+}
+
+// ecsDocument returns the Elastic Common Schema document of an event, or nil
+// if the event is not supported.
+func ecsDocument(response *fgs.GetEventsResponse) (document, error) {
+	process, parent := eventProcess(response)
+	if process == nil {
+		if response.GetProcessExec() != nil || response.GetProcessExit() != nil ||
+			response.GetProcessKprobe() != nil || response.GetProcessDns() != nil {
+			return nil, fmt.Errorf("process field is not set")
+		}
+		return nil, nil
+	}
+
+	doc := document{}
+	if validTime(response.Time) {
+		doc.set("@timestamp", response.Time.AsTime().Format(time.RFC3339Nano))
+	}
+	doc.set("ecs.version", ECSVersion)
+	doc.set("agent.type", "tetragon")
+	doc.set("agent.id", response.AgentId)
+	doc.set("host.name", response.NodeName)
+	doc.set("event.kind", "event")
+	doc.set("event.module", "tetragon")
+	if response.Sequence > 0 {
+		doc.set("event.sequence", response.Sequence)
+	}
+	ecsProcess(doc, "process", process)
+	ecsProcess(doc, "process.parent", parent)
+	ecsPod(doc, process.Pod)
+
+	var dataset string
+	switch ev := response.Event.(type) {
+	case *fgs.GetEventsResponse_ProcessExec:
+		dataset = "process_exec"
+		doc.set("event.category", []string{"process"})
+		doc.set("event.type", []string{"start"})
+		doc.set("event.action", "exec")
+	case *fgs.GetEventsResponse_ProcessExit:
+		dataset = "process_exit"
+		doc.set("event.category", []string{"process"})
+		doc.set("event.type", []string{"end"})
+		doc.set("event.action", "exit")
+		doc.set("process.exit_code", ev.ProcessExit.Status)
+		doc.set("event.reason", ev.ProcessExit.Signal)
+	case *fgs.GetEventsResponse_ProcessKprobe:
+		dataset = "process_kprobe"
+		kprobe := ev.ProcessKprobe
+		category, eventType := "process", "info"
+		if file := kprobeFile(kprobe); file != "" {
+			category, eventType = "file", "access"
+			doc.set("file.path", file)
+			doc.set("file.name", baseName(file))
+		} else if conn := kprobeConnection(kprobe); conn != nil {
+			category, eventType = "network", "connection"
+			ecsConnection(doc, conn)
+		}
+		types := []string{eventType}
+		if isEnforcement(kprobe.Action) {
+			types = append(types, "denied")
+		}
+		doc.set("event.category", []string{category})
+		doc.set("event.type", types)
+		doc.set("event.action", kprobe.FunctionName)
+		doc.set("rule.name", kprobe.PolicyName)
+	case *fgs.GetEventsResponse_ProcessDns:
+		dataset = "process_dns"
+		dns := ev.ProcessDns.Dns
+		if dns == nil {
+			return nil, fmt.Errorf("dns field is not set")
+		}
+		doc.set("event.category", []string{"network"})
+		doc.set("event.type", []string{"protocol"})
+		doc.set("network.protocol", "dns")
+		if dns.Response {
+			doc.set("event.action", "dns-answer")
+			doc.set("dns.type", "answer")
+			doc.set("dns.response_code", dnsResponseCodes[dns.Rcode])
+		} else {
+			doc.set("event.action", "dns-query")
+			doc.set("dns.type", "query")
+		}
+		doc.set("dns.question.name", strings.TrimSuffix(dns.Query, "."))
+		doc.set("dns.resolved_ip", dns.Ips)
+	}
+	doc.set("event.dataset", "tetragon."+dataset)
+	return doc, nil
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package encoder
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ecsEncode(t *testing.T, event *fgs.GetEventsResponse) string {
+	var buf bytes.Buffer
+	require.NoError(t, NewECSEncoder(&buf).Encode(event))
+	return buf.String()
+}
+
+func TestECSEncoder_Exec(t *testing.T) {
+	assert.JSONEq(t, `{
+		"@timestamp": "2022-05-17T10:30:00Z",
+		"ecs": {"version": "8.6.0"},
+		"agent": {"type": "tetragon", "id": "agent"},
+		"host": {"name": "node-1"},
+		"event": {
+			"kind": "event",
+			"module": "tetragon",
+			"dataset": "tetragon.process_exec",
+			"sequence": 7,
+			"category": ["process"],
+			"type": ["start"],
+			"action": "exec"
+		},
+		"process": {
+			"entity_id": "exec-id",
+			"pid": 42,
+			"executable": "/usr/bin/curl",
+			"name": "curl",
+			"args": ["/usr/bin/curl", "-s", "cilium.io"],
+			"args_count": 3,
+			"command_line": "/usr/bin/curl -s cilium.io",
+			"working_directory": "/home/user",
+			"start": "2022-05-17T10:30:00Z",
+			"user": {"id": "1000"},
+			"parent": {
+				"entity_id": "parent-id",
+				"pid": 1,
+				"executable": "/bin/sh",
+				"name": "sh",
+				"args": ["/bin/sh"],
+				"args_count": 1,
+				"command_line": "/bin/sh"
+			}
+		},
+		"container": {
+			"id": "abc",
+			"runtime": "containerd",
+			"name": "curl",
+			"image": {"name": "curlimages/curl:latest"}
+		},
+		"orchestrator": {
+			"type": "kubernetes",
+			"namespace": "default",
+			"resource": {"type": "pod", "name": "client", "label": ["app=client"]}
+		}
+	}`, ecsEncode(t, testExec()))
+}
+
+func TestECSEncoder_Exit(t *testing.T) {
+	res := testResponse()
+	res.Event = &fgs.GetEventsResponse_ProcessExit{ProcessExit: &fgs.ProcessExit{Process: testProcess(), Signal: "SIGKILL", Status: 9}}
+	doc, err := ecsDocument(res)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"end"}, doc["event"].(document)["type"])
+	assert.Equal(t, "SIGKILL", doc["event"].(document)["reason"])
+	assert.Equal(t, uint32(9), doc["process"].(document)["exit_code"])
+}
+
+func TestECSEncoder_Kprobe(t *testing.T) {
+	doc, err := ecsDocument(testKprobe(fgs.KprobeAction_KPROBE_ACTION_SIGKILL, "security_file_permission", fileArg("/etc/shadow")))
+	require.NoError(t, err)
+	event := doc["event"].(document)
+	assert.Equal(t, []string{"file"}, event["category"])
+	assert.Equal(t, []string{"access", "denied"}, event["type"])
+	assert.Equal(t, "security_file_permission", event["action"])
+	assert.Equal(t, document{"path": "/etc/shadow", "name": "shadow"}, doc["file"])
+	assert.Equal(t, document{"name": "policy"}, doc["rule"])
+
+	doc, err = ecsDocument(testKprobe(fgs.KprobeAction_KPROBE_ACTION_POST, "tcp_connect", sockArg()))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"connection"}, doc["event"].(document)["type"])
+	assert.Equal(t, document{"ip": "10.0.0.1", "port": uint32(40000)}, doc["source"])
+	assert.Equal(t, document{"ip": "1.1.1.1", "port": uint32(443)}, doc["destination"])
+	assert.Equal(t, document{"transport": "tcp", "type": "ipv4"}, doc["network"])
+
+	doc, err = ecsDocument(testKprobe(fgs.KprobeAction_KPROBE_ACTION_POST, "__x64_sys_setuid"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"process"}, doc["event"].(document)["category"])
+}
+
+func TestECSEncoder_DNS(t *testing.T) {
+	doc, err := ecsDocument(testDNS())
+	require.NoError(t, err)
+	assert.Equal(t, document{
+		"type":          "answer",
+		"response_code": "NOERROR",
+		"question":      document{"name": "cilium.io"},
+		"resolved_ip":   []string{"104.198.14.52"},
+	}, doc["dns"])
+	assert.Equal(t, "dns-answer", doc["event"].(document)["action"])
+}
+
+func TestECSEncoder_Unsupported(t *testing.T) {
+	var buf bytes.Buffer
+	e := NewECSEncoder(&buf)
+	assert.NoError(t, e.Encode(&fgs.GetEventsResponse{Event: &fgs.GetEventsResponse_RateLimitInfo{RateLimitInfo: &fgs.RateLimitInfo{}}}))
+	assert.Empty(t, buf.String())
+	assert.Error(t, e.Encode(&fgs.GetEventsResponse{Event: &fgs.GetEventsResponse_ProcessExec{ProcessExec: &fgs.ProcessExec{}}}))
+	assert.Error(t, e.Encode("event"))
+}
@@ -6,6 +6,7 @@ package encoder
 import (
 	"fmt"
 	"io"
+	"text/template"
 
 	"github.com/isovalent/tetragon-oss/api/v1/fgs"
 	"github.com/isovalent/tetragon-oss/pkg/logger"
@@ -29,13 +30,17 @@ const (
 type CompactEncoder struct {
 	writer  io.Writer
 	colorer *colorer
+	// messages are the parsed message templates of the tracing policies,
+	// nil if they are invalid.
+	messages map[string]*template.Template
 }
 
 // NewCompactEncoder initializes and returns a pointer to CompactEncoder.
 func NewCompactEncoder(w io.Writer, colorMode ColorMode) *CompactEncoder {
 	return &CompactEncoder{
-		writer:  w,
-		colorer: newColorer(colorMode),
+		writer:   w,
+		colorer:  newColorer(colorMode),
+		messages: map[string]*template.Template{},
 	}
 }
 
@@ -128,6 +133,11 @@ func (p *CompactEncoder) eventToString(response *fgs.GetEventsResponse) (string,
 			return "", fmt.Errorf("process field is not set")
 		}
 		processInfo, caps := p.colorer.processInfo(response.NodeName, kprobe.Process)
+		if kprobe.Message != "" {
+			if message, ok := p.renderMessage(kprobe.Message, kprobe, response.NodeName); ok {
+				return capTrailorPrinter(message, caps), nil
+			}
+		}
 		switch kprobe.FunctionName {
 		case "__x64_sys_write":
 			event := p.colorer.blue.Sprintf("📝 %-7s", "write")
@@ -240,6 +250,19 @@ func (p *CompactEncoder) eventToString(response *fgs.GetEventsResponse) (string,
 			event := p.colorer.blue.Sprintf("⁉️ %-7s", "syscall")
 			return capTrailorPrinter(fmt.Sprintf("%s %s %s", event, processInfo, kprobe.FunctionName), caps), nil
 		}
+	case *fgs.GetEventsResponse_ProcessTracepoint:
+		tp := response.GetProcessTracepoint()
+		if tp.Process == nil {
+			return "", fmt.Errorf("process field is not set")
+		}
+		processInfo, caps := p.colorer.processInfo(response.NodeName, tp.Process)
+		if tp.Message != "" {
+			if message, ok := p.renderMessage(tp.Message, tp, response.NodeName); ok {
+				return capTrailorPrinter(message, caps), nil
+			}
+		}
+		event := p.colorer.blue.Sprintf("⁉️ %-7s", "tracepoint")
+		return capTrailorPrinter(fmt.Sprintf("%s %s %s/%s", event, processInfo, tp.Subsys, tp.Event), caps), nil
 	case *fgs.GetEventsResponse_ProcessDns:
 		dns := response.GetProcessDns()
 		if dns.Process == nil {
@@ -252,6 +275,19 @@ func (p *CompactEncoder) eventToString(response *fgs.GetEventsResponse) (string,
 		processInfo, caps := p.colorer.processInfo(response.NodeName, dns.Process)
 		args := p.colorer.cyan.Sprint(dns.GetDns().Names, " => ", dns.GetDns().Ips)
 		return capTrailorPrinter(fmt.Sprintf("%s %s %s", event, processInfo, args), caps), nil
+	case *fgs.GetEventsResponse_EventsDropped:
+		event := p.colorer.red.Sprintf("⚠️ %-7s", "dropped")
+		return fmt.Sprintf("%s %d events were dropped because the client is too slow", event, response.GetEventsDropped().Count), nil
+	case *fgs.GetEventsResponse_LostEvents:
+		lost := response.GetLostEvents()
+		event := p.colorer.red.Sprintf("⚠️ %-7s", "lost")
+		if lost.Sensor != "" {
+			return fmt.Sprintf("%s %d %s events were lost on CPU %d", event, lost.Count, lost.Sensor, lost.Cpu), nil
+		}
+		return fmt.Sprintf("%s %d events were lost on CPU %d", event, lost.Count, lost.Cpu), nil
+	case *fgs.GetEventsResponse_RateLimitInfo:
+		event := p.colorer.red.Sprintf("⚠️ %-7s", "limited")
+		return fmt.Sprintf("%s %d events were dropped by the rate limit", event, response.GetRateLimitInfo().NumberOfDroppedProcessEvents), nil
 	}
 	return "", fmt.Errorf("unknown event type")
 }
@@ -352,3 +352,68 @@ func TestCompactEncoder_Encode(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "🚀 process kube-system/hubble-enterprise /usr/bin/curl isovalent.com\n", b.String())
 }
+
+func TestCompactEncoder_KprobeMessageEventToString(t *testing.T) {
+	p := NewCompactEncoder(os.Stdout, Never)
+
+	event := &fgs.GetEventsResponse{
+		Event: &fgs.GetEventsResponse_ProcessKprobe{
+			ProcessKprobe: &fgs.ProcessKprobe{
+				Process: &fgs.Process{
+					Binary: "/usr/bin/cat",
+				},
+				FunctionName: "security_file_permission",
+				Args: []*fgs.KprobeArgument{
+					{Arg: &fgs.KprobeArgument_FileArg{FileArg: &fgs.KprobeFile{Path: "/etc/shadow"}}},
+					{Arg: &fgs.KprobeArgument_IntArg{IntArg: 4}},
+				},
+				Return:     &fgs.KprobeArgument{Arg: &fgs.KprobeArgument_IntArg{IntArg: -13}},
+				PolicyName: "sensitive-files",
+				Message:    "🔑 {{.process.binary}} opened {{.args.path}} with mask {{index .arg 1}} on {{.node_name}}: {{.return}} ({{.policy_name}})",
+			},
+		},
+		NodeName: "my-node",
+	}
+	result, err := p.eventToString(event)
+	assert.NoError(t, err)
+	assert.Equal(t, "🔑 /usr/bin/cat opened /etc/shadow with mask 4 on my-node: -13 (sensitive-files)", result)
+
+	// invalid templates are ignored
+	event.GetProcessKprobe().Message = "{{.process.binary"
+	result, err = p.eventToString(event)
+	assert.NoError(t, err)
+	assert.Equal(t, "⁉️ syscall my-node /usr/bin/cat security_file_permission", result)
+	event.GetProcessKprobe().Message = "{{.args.path.name}}"
+	result, err = p.eventToString(event)
+	assert.NoError(t, err)
+	assert.Equal(t, "⁉️ syscall my-node /usr/bin/cat security_file_permission", result)
+}
+
+func TestCompactEncoder_TracepointEventToString(t *testing.T) {
+	p := NewCompactEncoder(os.Stdout, Never)
+
+	event := &fgs.GetEventsResponse{
+		Event: &fgs.GetEventsResponse_ProcessTracepoint{
+			ProcessTracepoint: &fgs.ProcessTracepoint{
+				Process: &fgs.Process{
+					Binary: "/usr/bin/curl",
+				},
+				Subsys: "syscalls",
+				Event:  "sys_enter_connect",
+				Args: []*fgs.KprobeArgument{
+					{Arg: &fgs.KprobeArgument_StringArg{StringArg: "first"}},
+					{Arg: &fgs.KprobeArgument_StringArg{StringArg: "second"}},
+				},
+			},
+		},
+		NodeName: "my-node",
+	}
+	result, err := p.eventToString(event)
+	assert.NoError(t, err)
+	assert.Equal(t, "⁉️ tracepoint my-node /usr/bin/curl syscalls/sys_enter_connect", result)
+
+	event.GetProcessTracepoint().Message = "🔌 {{.process.binary}} {{.args.string_arg}} {{index .arg 1}}"
+	result, err = p.eventToString(event)
+	assert.NoError(t, err)
+	assert.Equal(t, "🔌 /usr/bin/curl first second", result)
+}
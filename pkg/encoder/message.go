@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package encoder
+
+import (
+	"bytes"
+	"encoding/json"
+	"text/template"
+
+	"github.com/isovalent/tetragon-oss/pkg/logger"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// messageData returns the data of the message template of a kprobe or
+// tracepoint event: its fields as they are exported to JSON, such as
+// .process.binary or .policy_name, and the node_name of the event.
+//
+// The arguments are simplified so that templates do not depend on their
+// types. .arg is the list of the values of the arguments, such as the path
+// object of a file argument, or the string of a string argument. .args merges
+// the fields of the arguments, such as .args.path for a file or path argument
+// and .args.daddr for a sock argument, and has the values of the arguments by
+// type, such as .args.string_arg. The first argument wins when several have a
+// field. .return is the value of the return argument.
+func messageData(event proto.Message, nodeName string) (map[string]interface{}, error) {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	fields["node_name"] = nodeName
+
+	args, _ := fields["args"].([]interface{})
+	merged := map[string]interface{}{}
+	values := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		kind, value := argValue(arg)
+		values = append(values, value)
+		if kind == "" {
+			continue
+		}
+		if _, ok := merged[kind]; !ok {
+			merged[kind] = value
+		}
+		if obj, ok := value.(map[string]interface{}); ok {
+			for k, v := range obj {
+				if _, ok := merged[k]; !ok {
+					merged[k] = v
+				}
+			}
+		}
+	}
+	fields["args"] = merged
+	fields["arg"] = values
+	if ret, ok := fields["return"]; ok {
+		_, fields["return"] = argValue(ret)
+	}
+	return fields, nil
+}
+
+// argValue returns the type and value of a KprobeArgument exported to JSON,
+// whose only field is its value.
+func argValue(arg interface{}) (string, interface{}) {
+	obj, _ := arg.(map[string]interface{})
+	for kind, value := range obj {
+		return kind, value
+	}
+	return "", nil
+}
+
+// renderMessage renders the message template of a kprobe or tracepoint event.
+// It returns false if the template is invalid, so that the event is printed
+// as if it had no message.
+func (p *CompactEncoder) renderMessage(message string, event proto.Message, nodeName string) (string, bool) {
+	tmpl, ok := p.messages[message]
+	if !ok {
+		var err error
+		tmpl, err = template.New("message").Parse(message)
+		if err != nil {
+			logger.GetLogger().WithError(err).WithField("message", message).Debug("Invalid message template")
+		}
+		p.messages[message] = tmpl
+	}
+	if tmpl == nil {
+		return "", false
+	}
+	data, err := messageData(event, nodeName)
+	if err != nil {
+		return "", false
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		logger.GetLogger().WithError(err).WithField("message", message).Debug("Failed to render message template")
+		return "", false
+	}
+	return buf.String(), true
+}
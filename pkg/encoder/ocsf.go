@@ -0,0 +1,260 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package encoder
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+)
+
+// OCSFVersion is the version of the Open Cybersecurity Schema Framework of
+// OCSFEncoder.
+const OCSFVersion = "1.1.0"
+
+// OCSF classes and their categories.
+const (
+	ocsfFileSystemActivity = 1001
+	ocsfProcessActivity    = 1007
+	ocsfNetworkActivity    = 4001
+	ocsfDNSActivity        = 4003
+
+	ocsfSystemActivity  = 1
+	ocsfNetworkCategory = 4
+
+	ocsfActivityOther = 99
+)
+
+var ocsfClasses = map[int]struct {
+	name        string
+	category    int
+	categoryStr string
+}{
+	ocsfFileSystemActivity: {"File System Activity", ocsfSystemActivity, "System Activity"},
+	ocsfProcessActivity:    {"Process Activity", ocsfSystemActivity, "System Activity"},
+	ocsfNetworkActivity:    {"Network Activity", ocsfNetworkCategory, "Network Activity"},
+	ocsfDNSActivity:        {"DNS Activity", ocsfNetworkCategory, "Network Activity"},
+}
+
+// ocsfFileActivities are the File System Activity activities of kprobe
+// functions, matched by substring.
+var ocsfFileActivities = []struct {
+	function string
+	id       int
+	name     string
+}{
+	{"unlink", 4, "Delete"},
+	{"rename", 5, "Rename"},
+	{"umount", 13, "Unmount"},
+	{"mount", 12, "Mount"},
+	{"open", 14, "Open"},
+	{"read", 2, "Read"},
+	{"write", 3, "Update"},
+	{"create", 1, "Create"},
+}
+
+// OCSFEncoder encodes fgs.GetEventsResponse as Open Cybersecurity Schema
+// Framework events, one JSON object per line. Process exec, exit and kprobe
+// events are Process Activity events, kprobes with a file argument are File
+// System Activity events, kprobes with a sock or skb argument are Network
+// Activity events, and dns events are DNS Activity events. Other events are
+// skipped.
+type OCSFEncoder struct {
+	encoder *json.Encoder
+}
+
+// NewOCSFEncoder initializes and returns a pointer to OCSFEncoder.
+func NewOCSFEncoder(w io.Writer) *OCSFEncoder {
+	return &OCSFEncoder{encoder: json.NewEncoder(w)}
+}
+
+// Encode implements EventEncoder.Encode.
+func (e *OCSFEncoder) Encode(v interface{}) error {
+	event, ok := v.(*fgs.GetEventsResponse)
+	if !ok {
+		return fmt.Errorf("invalid event")
+	}
+	doc, err := ocsfDocument(event)
+	if err != nil || doc == nil {
+		return err
+	}
+	return e.encoder.Encode(doc)
+}
+
+// ocsfProcess returns the OCSF process object of a process.
+func ocsfProcess(p *fgs.Process, parent *fgs.Process) document {
+	if p == nil {
+		return nil
+	}
+	doc := document{}
+	doc.set("uid", p.ExecId)
+	if p.Pid != nil {
+		doc.set("pid", p.Pid.Value)
+	}
+	doc.set("name", baseName(p.Binary))
+	doc.set("cmd_line", commandLine(p))
+	if p.Binary != "" {
+		doc.set("file.path", p.Binary)
+		doc.set("file.name", baseName(p.Binary))
+		doc.set("file.type_id", 1)
+	}
+	if validTime(p.StartTime) {
+		doc.set("created_time", p.StartTime.AsTime().UnixNano()/1e6)
+	}
+	if p.Uid != nil {
+		doc.set("user.uid", strconv.FormatUint(uint64(p.Uid.Value), 10))
+	}
+	if pod := p.Pod; pod != nil && pod.Container != nil {
+		c := pod.Container
+		runtime, id := splitContainerID(c.Id)
+		doc.set("container.runtime", runtime)
+		doc.set("container.uid", id)
+		doc.set("container.name", c.Name)
+		if c.Image != nil {
+			doc.set("container.image.name", c.Image.Name)
+			doc.set("container.image.uid", c.Image.Id)
+		}
+		doc.set("container.orchestrator", "kubernetes")
+	}
+	doc.set("parent_process", ocsfProcess(parent, nil))
+	return doc
+}
+
+// ocsfEndpoint returns a network endpoint.
+func ocsfEndpoint(ip string, port uint32) document {
+	doc := document{}
+	doc.set("ip", ip)
+	if port > 0 {
+		doc.set("port", port)
+	}
+	return doc
+}
+
+// ocsfDocument returns the OCSF event of an event, or nil if the event is not
+// supported.
+func ocsfDocument(response *fgs.GetEventsResponse) (document, error) {
+	process, parent := eventProcess(response)
+	if process == nil {
+		if response.GetProcessExec() != nil || response.GetProcessExit() != nil ||
+			response.GetProcessKprobe() != nil || response.GetProcessDns() != nil {
+			return nil, fmt.Errorf("process field is not set")
+		}
+		return nil, nil
+	}
+
+	doc := document{}
+	if validTime(response.Time) {
+		doc.set("time", response.Time.AsTime().UnixNano()/1e6)
+	}
+	doc.set("severity_id", 1)
+	doc.set("severity", "Informational")
+	doc.set("metadata.version", OCSFVersion)
+	doc.set("metadata.product.name", "Tetragon")
+	doc.set("metadata.product.vendor_name", "Isovalent")
+	if response.Sequence > 0 {
+		doc.set("metadata.sequence", response.Sequence)
+	}
+	doc.set("device.hostname", response.NodeName)
+	doc.set("device.type_id", 0)
+	if pod := process.Pod; pod != nil {
+		doc.set("unmapped.pod.namespace", pod.Namespace)
+		doc.set("unmapped.pod.name", pod.Name)
+		doc.set("unmapped.pod.labels", pod.Labels)
+	}
+
+	var class, activity int
+	var activityName string
+	switch ev := response.Event.(type) {
+	case *fgs.GetEventsResponse_ProcessExec:
+		class, activity, activityName = ocsfProcessActivity, 1, "Launch"
+		doc.set("process", ocsfProcess(process, parent))
+		doc.set("actor.process", ocsfProcess(parent, nil))
+	case *fgs.GetEventsResponse_ProcessExit:
+		class, activity, activityName = ocsfProcessActivity, 2, "Terminate"
+		doc.set("process", ocsfProcess(process, parent))
+		doc.set("actor.process", ocsfProcess(parent, nil))
+		doc.set("exit_code", ev.ProcessExit.Status)
+		doc.set("unmapped.signal", ev.ProcessExit.Signal)
+	case *fgs.GetEventsResponse_ProcessKprobe:
+		kprobe := ev.ProcessKprobe
+		actor := ocsfProcess(process, parent)
+		if file := kprobeFile(kprobe); file != "" {
+			class, activity, activityName = ocsfFileSystemActivity, ocsfActivityOther, "Other"
+			for _, a := range ocsfFileActivities {
+				if strings.Contains(kprobe.FunctionName, a.function) {
+					activity, activityName = a.id, a.name
+					break
+				}
+			}
+			doc.set("file.path", file)
+			doc.set("file.name", baseName(file))
+			doc.set("file.type_id", 0)
+			doc.set("actor.process", actor)
+		} else if conn := kprobeConnection(kprobe); conn != nil {
+			class, activity, activityName = ocsfNetworkActivity, 6, "Traffic"
+			switch {
+			case strings.Contains(kprobe.FunctionName, "connect"):
+				activity, activityName = 1, "Open"
+			case strings.Contains(kprobe.FunctionName, "close"):
+				activity, activityName = 2, "Close"
+			}
+			doc.set("src_endpoint", ocsfEndpoint(conn.saddr, conn.sport))
+			doc.set("dst_endpoint", ocsfEndpoint(conn.daddr, conn.dport))
+			doc.set("connection_info.protocol_name", conn.transport)
+			switch conn.ipVersion() {
+			case "ipv4":
+				doc.set("connection_info.protocol_ver_id", 4)
+			case "ipv6":
+				doc.set("connection_info.protocol_ver_id", 6)
+			}
+			doc.set("actor.process", actor)
+		} else {
+			class, activity, activityName = ocsfProcessActivity, ocsfActivityOther, "Other"
+			doc.set("process", actor)
+			doc.set("actor.process", actor)
+		}
+		if isEnforcement(kprobe.Action) {
+			doc.set("action_id", 2)
+			doc.set("action", "Denied")
+			doc.set("disposition_id", 2)
+			doc.set("disposition", "Blocked")
+		}
+		doc.set("message", kprobe.FunctionName)
+		doc.set("unmapped.function_name", kprobe.FunctionName)
+		doc.set("unmapped.policy_name", kprobe.PolicyName)
+	case *fgs.GetEventsResponse_ProcessDns:
+		dns := ev.ProcessDns.Dns
+		if dns == nil {
+			return nil, fmt.Errorf("dns field is not set")
+		}
+		class, activity, activityName = ocsfDNSActivity, 1, "Query"
+		if dns.Response {
+			activity, activityName = 2, "Response"
+			doc.set("rcode_id", dns.Rcode)
+			doc.set("rcode", dnsResponseCodes[dns.Rcode])
+			var answers []interface{}
+			for _, ip := range dns.Ips {
+				answers = append(answers, document{"rdata": ip})
+			}
+			doc.set("answers", answers)
+		}
+		doc.set("query.hostname", strings.TrimSuffix(dns.Query, "."))
+		doc.set("actor.process", ocsfProcess(process, parent))
+	}
+
+	c := ocsfClasses[class]
+	doc.set("class_uid", class)
+	doc.set("class_name", c.name)
+	doc.set("category_uid", c.category)
+	doc.set("category_name", c.categoryStr)
+	doc.set("activity_id", activity)
+	doc.set("activity_name", activityName)
+	doc.set("type_uid", class*100+activity)
+	doc.set("type_name", c.name+": "+activityName)
+	return doc, nil
+}
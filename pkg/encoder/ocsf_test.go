@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package encoder
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOCSFEncoder_Exec(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, NewOCSFEncoder(&buf).Encode(testExec()))
+	assert.JSONEq(t, `{
+		"class_uid": 1007,
+		"class_name": "Process Activity",
+		"category_uid": 1,
+		"category_name": "System Activity",
+		"activity_id": 1,
+		"activity_name": "Launch",
+		"type_uid": 100701,
+		"type_name": "Process Activity: Launch",
+		"time": 1652783400000,
+		"severity_id": 1,
+		"severity": "Informational",
+		"metadata": {
+			"version": "1.1.0",
+			"product": {"name": "Tetragon", "vendor_name": "Isovalent"},
+			"sequence": 7
+		},
+		"device": {"hostname": "node-1", "type_id": 0},
+		"process": {
+			"uid": "exec-id",
+			"pid": 42,
+			"name": "curl",
+			"cmd_line": "/usr/bin/curl -s cilium.io",
+			"file": {"path": "/usr/bin/curl", "name": "curl", "type_id": 1},
+			"created_time": 1652783400000,
+			"user": {"uid": "1000"},
+			"container": {
+				"uid": "abc",
+				"runtime": "containerd",
+				"name": "curl",
+				"image": {"name": "curlimages/curl:latest", "uid": "sha256:def"},
+				"orchestrator": "kubernetes"
+			},
+			"parent_process": {
+				"uid": "parent-id",
+				"pid": 1,
+				"name": "sh",
+				"cmd_line": "/bin/sh",
+				"file": {"path": "/bin/sh", "name": "sh", "type_id": 1}
+			}
+		},
+		"actor": {
+			"process": {
+				"uid": "parent-id",
+				"pid": 1,
+				"name": "sh",
+				"cmd_line": "/bin/sh",
+				"file": {"path": "/bin/sh", "name": "sh", "type_id": 1}
+			}
+		},
+		"unmapped": {"pod": {"namespace": "default", "name": "client", "labels": ["app=client"]}}
+	}`, buf.String())
+}
+
+func TestOCSFEncoder_Kprobe(t *testing.T) {
+	doc, err := ocsfDocument(testKprobe(fgs.KprobeAction_KPROBE_ACTION_OVERRIDE, "security_file_open", fileArg("/etc/shadow")))
+	require.NoError(t, err)
+	assert.Equal(t, 1001, doc["class_uid"])
+	assert.Equal(t, 14, doc["activity_id"])
+	assert.Equal(t, 100114, doc["type_uid"])
+	assert.Equal(t, 2, doc["action_id"])
+	assert.Equal(t, 2, doc["disposition_id"])
+	assert.Equal(t, "/etc/shadow", doc["file"].(document)["path"])
+	assert.Equal(t, "exec-id", doc["actor"].(document)["process"].(document)["uid"])
+	assert.Equal(t, "policy", doc["unmapped"].(document)["policy_name"])
+
+	doc, err = ocsfDocument(testKprobe(fgs.KprobeAction_KPROBE_ACTION_POST, "tcp_connect", sockArg()))
+	require.NoError(t, err)
+	assert.Equal(t, 4001, doc["class_uid"])
+	assert.Equal(t, 4, doc["category_uid"])
+	assert.Equal(t, 1, doc["activity_id"])
+	assert.Equal(t, document{"ip": "1.1.1.1", "port": uint32(443)}, doc["dst_endpoint"])
+	assert.Equal(t, document{"protocol_name": "tcp", "protocol_ver_id": 4}, doc["connection_info"])
+	assert.NotContains(t, doc, "action_id")
+
+	doc, err = ocsfDocument(testKprobe(fgs.KprobeAction_KPROBE_ACTION_POST, "__x64_sys_setuid"))
+	require.NoError(t, err)
+	assert.Equal(t, 1007, doc["class_uid"])
+	assert.Equal(t, 99, doc["activity_id"])
+}
+
+func TestOCSFEncoder_DNS(t *testing.T) {
+	doc, err := ocsfDocument(testDNS())
+	require.NoError(t, err)
+	assert.Equal(t, 4003, doc["class_uid"])
+	assert.Equal(t, 2, doc["activity_id"])
+	assert.Equal(t, "NOERROR", doc["rcode"])
+	assert.Equal(t, document{"hostname": "cilium.io"}, doc["query"])
+	assert.Equal(t, []interface{}{document{"rdata": "104.198.14.52"}}, doc["answers"])
+}
+
+func TestOCSFEncoder_Unsupported(t *testing.T) {
+	doc, err := ocsfDocument(&fgs.GetEventsResponse{Event: &fgs.GetEventsResponse_LostEvents{LostEvents: &fgs.LostEvents{}}})
+	assert.NoError(t, err)
+	assert.Nil(t, doc)
+	_, err = ocsfDocument(&fgs.GetEventsResponse{Event: &fgs.GetEventsResponse_ProcessDns{ProcessDns: &fgs.ProcessDns{Process: testProcess()}}})
+	assert.Error(t, err)
+}
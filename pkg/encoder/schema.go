@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package encoder
+
+import (
+	"net"
+	"path"
+	"strings"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/isovalent/tetragon-oss/pkg/reader/network"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// document is a JSON object of the ECS and OCSF encoders.
+type document map[string]interface{}
+
+// set sets the field at a dotted path, such as "process.parent.pid", creating
+// the intermediate objects. Empty strings, slices and nil values are not set.
+func (d document) set(p string, v interface{}) {
+	switch value := v.(type) {
+	case nil:
+		return
+	case string:
+		if value == "" {
+			return
+		}
+	case []string:
+		if len(value) == 0 {
+			return
+		}
+	case []interface{}:
+		if len(value) == 0 {
+			return
+		}
+	case document:
+		if len(value) == 0 {
+			return
+		}
+	}
+	obj := d
+	keys := strings.Split(p, ".")
+	for _, key := range keys[:len(keys)-1] {
+		child, ok := obj[key].(document)
+		if !ok {
+			child = document{}
+			obj[key] = child
+		}
+		obj = child
+	}
+	obj[keys[len(keys)-1]] = v
+}
+
+// processArgs returns the arguments of a process, starting with the binary.
+// Arguments are split on spaces, as they are exported as a single string.
+func processArgs(p *fgs.Process) []string {
+	if p.Binary == "" {
+		return nil
+	}
+	return append([]string{p.Binary}, strings.Fields(p.Arguments)...)
+}
+
+func commandLine(p *fgs.Process) string {
+	return strings.TrimSpace(p.Binary + " " + p.Arguments)
+}
+
+func baseName(p string) string {
+	if p == "" {
+		return ""
+	}
+	return path.Base(p)
+}
+
+// splitContainerID splits a container ID into its runtime and the ID of the
+// runtime, such as containerd and abc for containerd://abc.
+func splitContainerID(id string) (string, string) {
+	if i := strings.Index(id, "://"); i > 0 {
+		return id[:i], id[i+3:]
+	}
+	return "", id
+}
+
+// eventProcess returns the process and parent of the events that the ECS and
+// OCSF encoders support.
+func eventProcess(response *fgs.GetEventsResponse) (*fgs.Process, *fgs.Process) {
+	switch ev := response.Event.(type) {
+	case *fgs.GetEventsResponse_ProcessExec:
+		return ev.ProcessExec.Process, ev.ProcessExec.Parent
+	case *fgs.GetEventsResponse_ProcessExit:
+		return ev.ProcessExit.Process, ev.ProcessExit.Parent
+	case *fgs.GetEventsResponse_ProcessKprobe:
+		return ev.ProcessKprobe.Process, ev.ProcessKprobe.Parent
+	case *fgs.GetEventsResponse_ProcessDns:
+		return ev.ProcessDns.Process, nil
+	}
+	return nil, nil
+}
+
+// connection is the connection of the sock or skb argument of a kprobe.
+type connection struct {
+	transport    string
+	saddr, daddr string
+	sport, dport uint32
+}
+
+// ipVersion returns ipv4 or ipv6.
+func (c *connection) ipVersion() string {
+	ip := net.ParseIP(c.saddr)
+	if ip == nil {
+		ip = net.ParseIP(c.daddr)
+	}
+	switch {
+	case ip == nil:
+		return ""
+	case ip.To4() != nil:
+		return "ipv4"
+	}
+	return "ipv6"
+}
+
+func transport(protocol string) string {
+	return strings.ToLower(strings.TrimPrefix(protocol, "IPPROTO_"))
+}
+
+// kprobeFile returns the path of the first file or path argument of a
+// kprobe.
+func kprobeFile(kprobe *fgs.ProcessKprobe) string {
+	for _, arg := range kprobe.Args {
+		switch a := arg.GetArg().(type) {
+		case *fgs.KprobeArgument_FileArg:
+			return a.FileArg.Path
+		case *fgs.KprobeArgument_PathArg:
+			return a.PathArg.Path
+		}
+	}
+	return ""
+}
+
+// kprobeConnection returns the connection of the first sock or skb argument
+// of a kprobe.
+func kprobeConnection(kprobe *fgs.ProcessKprobe) *connection {
+	for _, arg := range kprobe.Args {
+		switch a := arg.GetArg().(type) {
+		case *fgs.KprobeArgument_SockArg:
+			s := a.SockArg
+			return &connection{transport(s.Protocol), s.Saddr, s.Daddr, s.Sport, s.Dport}
+		case *fgs.KprobeArgument_SkbArg:
+			s := a.SkbArg
+			return &connection{transport(network.InetProtocol(uint16(s.Proto))), s.Saddr, s.Daddr, s.Sport, s.Dport}
+		}
+	}
+	return nil
+}
+
+// isEnforcement returns true if the action of a kprobe prevents the operation.
+func isEnforcement(action fgs.KprobeAction) bool {
+	return action == fgs.KprobeAction_KPROBE_ACTION_SIGKILL || action == fgs.KprobeAction_KPROBE_ACTION_OVERRIDE
+}
+
+// dnsResponseCodes are the names of the DNS response codes.
+var dnsResponseCodes = map[int32]string{
+	0: "NOERROR",
+	1: "FORMERR",
+	2: "SERVFAIL",
+	3: "NXDOMAIN",
+	4: "NOTIMP",
+	5: "REFUSED",
+}
+
+func validTime(t *timestamppb.Timestamp) bool {
+	return t != nil && t.IsValid()
+}
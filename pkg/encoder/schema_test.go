@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package encoder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var testTime = timestamppb.New(time.Date(2022, 5, 17, 10, 30, 0, 0, time.UTC))
+
+func testProcess() *fgs.Process {
+	return &fgs.Process{
+		ExecId:    "exec-id",
+		Pid:       wrapperspb.UInt32(42),
+		Uid:       wrapperspb.UInt32(1000),
+		Cwd:       "/home/user",
+		Binary:    "/usr/bin/curl",
+		Arguments: "-s cilium.io",
+		StartTime: testTime,
+		Pod: &fgs.Pod{
+			Namespace: "default",
+			Name:      "client",
+			Labels:    []string{"app=client"},
+			Container: &fgs.Container{
+				Id:    "containerd://abc",
+				Name:  "curl",
+				Image: &fgs.Image{Id: "sha256:def", Name: "curlimages/curl:latest"},
+			},
+		},
+	}
+}
+
+func testParent() *fgs.Process {
+	return &fgs.Process{ExecId: "parent-id", Pid: wrapperspb.UInt32(1), Binary: "/bin/sh"}
+}
+
+func testResponse() *fgs.GetEventsResponse {
+	return &fgs.GetEventsResponse{NodeName: "node-1", Time: testTime, AgentId: "agent", Sequence: 7}
+}
+
+func testExec() *fgs.GetEventsResponse {
+	res := testResponse()
+	res.Event = &fgs.GetEventsResponse_ProcessExec{ProcessExec: &fgs.ProcessExec{Process: testProcess(), Parent: testParent()}}
+	return res
+}
+
+func testKprobe(action fgs.KprobeAction, function string, args ...*fgs.KprobeArgument) *fgs.GetEventsResponse {
+	res := testResponse()
+	res.Event = &fgs.GetEventsResponse_ProcessKprobe{ProcessKprobe: &fgs.ProcessKprobe{
+		Process:      testProcess(),
+		Parent:       testParent(),
+		FunctionName: function,
+		Args:         args,
+		Action:       action,
+		PolicyName:   "policy",
+	}}
+	return res
+}
+
+func fileArg(path string) *fgs.KprobeArgument {
+	return &fgs.KprobeArgument{Arg: &fgs.KprobeArgument_FileArg{FileArg: &fgs.KprobeFile{Path: path}}}
+}
+
+func sockArg() *fgs.KprobeArgument {
+	return &fgs.KprobeArgument{Arg: &fgs.KprobeArgument_SockArg{SockArg: &fgs.KprobeSock{
+		Family:   "AF_INET",
+		Protocol: "IPPROTO_TCP",
+		Saddr:    "10.0.0.1",
+		Daddr:    "1.1.1.1",
+		Sport:    40000,
+		Dport:    443,
+	}}}
+}
+
+func testDNS() *fgs.GetEventsResponse {
+	res := testResponse()
+	res.Event = &fgs.GetEventsResponse_ProcessDns{ProcessDns: &fgs.ProcessDns{
+		Process: testProcess(),
+		Dns: &fgs.DnsInfo{
+			Query:    "cilium.io.",
+			Response: true,
+			Rcode:    0,
+			Ips:      []string{"104.198.14.52"},
+		},
+	}}
+	return res
+}
+
+func TestDocumentSet(t *testing.T) {
+	doc := document{}
+	doc.set("a.b.c", 1)
+	doc.set("a.b.d", "x")
+	doc.set("a.e", "")
+	doc.set("a.f", []string{})
+	doc.set("a.g", document(nil))
+	doc.set("h", 0)
+	assert.Equal(t, document{"a": document{"b": document{"c": 1, "d": "x"}}, "h": 0}, doc)
+}
+
+func TestSplitContainerID(t *testing.T) {
+	runtime, id := splitContainerID("containerd://abc")
+	assert.Equal(t, "containerd", runtime)
+	assert.Equal(t, "abc", id)
+	runtime, id = splitContainerID("abc")
+	assert.Equal(t, "", runtime)
+	assert.Equal(t, "abc", id)
+}
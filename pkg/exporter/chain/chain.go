@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package chain makes JSON export files tamper-evident. Each record gets an
+// export_chain field with its index and the SHA-256 hash of the hash of the
+// previous record followed by the record as written without the field. The
+// chain is signed by checkpoints, which are records with only an export_chain
+// field, whose signature is an Ed25519 signature of the index and hash of the
+// last record.
+//
+// Altering or removing a record breaks the chain, and the signatures prevent
+// recomputing it. Records are protected once a checkpoint follows them, the
+// verification reports the records that are not.
+package chain
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+)
+
+const fieldName = `"export_chain":`
+
+// nextHash returns the hash of a record.
+func nextHash(prev []byte, record []byte) []byte {
+	h := sha256.New()
+	h.Write(prev)
+	h.Write(record)
+	return h.Sum(nil)
+}
+
+// signedMessage is the message that checkpoints sign.
+func signedMessage(index uint64, hash []byte) []byte {
+	msg := []byte("tetragon export chain\x00")
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], index)
+	msg = append(msg, b[:]...)
+	return append(msg, hash...)
+}
+
+// appendChain adds the export_chain field to a JSON object.
+func appendChain(line []byte, index uint64, hash []byte, signature []byte, keyID string) []byte {
+	ret := make([]byte, 0, len(line)+160)
+	ret = append(ret, line[:len(line)-1]...)
+	if len(bytes.TrimSpace(ret)) > 1 {
+		ret = append(ret, ',')
+	}
+	ret = append(ret, fieldName...)
+	ret = append(ret, `{"index":"`...)
+	ret = strconv.AppendUint(ret, index, 10)
+	ret = append(ret, `","hash":"`...)
+	ret = append(ret, hex.EncodeToString(hash)...)
+	ret = append(ret, '"')
+	if signature != nil {
+		ret = append(ret, `,"signature":"`...)
+		ret = append(ret, base64.StdEncoding.EncodeToString(signature)...)
+		ret = append(ret, `","key_id":"`...)
+		ret = append(ret, keyID...)
+		ret = append(ret, '"')
+	}
+	return append(ret, "}}"...)
+}
+
+// splitChain splits a line into the record as it was before the export_chain
+// field was added, and that field.
+func splitChain(line []byte) ([]byte, *fgs.ExportChain, error) {
+	line = bytes.TrimRight(line, "\r\n")
+	i := bytes.LastIndex(line, []byte(fieldName))
+	if i <= 0 || len(line) < 2 || line[len(line)-1] != '}' {
+		return nil, nil, errors.New("record has no export_chain field")
+	}
+	var chain fgs.ExportChain
+	if err := chain.UnmarshalJSON(line[i+len(fieldName) : len(line)-1]); err != nil {
+		return nil, nil, fmt.Errorf("invalid export_chain field: %w", err)
+	}
+	var record []byte
+	switch line[i-1] {
+	case ',':
+		record = append(record, line[:i-1]...)
+	case '{':
+		record = append(record, line[:i]...)
+	default:
+		return nil, nil, errors.New("invalid export_chain field")
+	}
+	record = append(record, '}')
+	return record, &chain, nil
+}
+
+func decodeHash(s string) ([]byte, error) {
+	hash, err := hex.DecodeString(s)
+	if err != nil || len(hash) != sha256.Size {
+		return nil, fmt.Errorf("invalid hash %q", s)
+	}
+	return hash, nil
+}
+
+// KeyID returns the ID of a public key.
+func KeyID(key ed25519.PublicKey) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:])
+}
+
+func readPEM(path string) (*pem.Block, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s is not a PEM file", path)
+	}
+	return block, nil
+}
+
+func parsePrivateKey(block *pem.Block) (ed25519.PrivateKey, error) {
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	edKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%T is not an Ed25519 key", key)
+	}
+	return edKey, nil
+}
+
+// LoadPrivateKey loads an Ed25519 private key from a PKCS #8 PEM file, as
+// created by "openssl genpkey -algorithm ed25519".
+func LoadPrivateKey(path string) (ed25519.PrivateKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := parsePrivateKey(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key %s: %w", path, err)
+	}
+	return key, nil
+}
+
+// LoadPublicKey loads an Ed25519 public key from a PEM file, as created by
+// "openssl pkey -pubout". The key of a private key file is also accepted.
+func LoadPublicKey(path string) (ed25519.PublicKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+	if block.Type == "PRIVATE KEY" {
+		key, err := parsePrivateKey(block)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse private key %s: %w", path, err)
+		}
+		return key.Public().(ed25519.PublicKey), nil
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key %s: %w", path, err)
+	}
+	edKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%s: %T is not an Ed25519 key", path, key)
+	}
+	return edKey, nil
+}
@@ -0,0 +1,316 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package chain
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type nopCloser struct {
+	*bytes.Buffer
+}
+
+func (nopCloser) Close() error { return nil }
+
+func newKey(t *testing.T) ed25519.PrivateKey {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func writeRecords(t *testing.T, w *Writer, records ...string) {
+	for _, r := range records {
+		_, err := w.Write([]byte(r + "\n"))
+		require.NoError(t, err)
+	}
+}
+
+func lines(data []byte) [][]byte {
+	var ret [][]byte
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for s.Scan() {
+		ret = append(ret, append([]byte(nil), s.Bytes()...))
+	}
+	return ret
+}
+
+// verify verifies lines, and returns their kinds and the number of errors.
+func verify(key ed25519.PrivateKey, lines [][]byte) ([]RecordKind, int) {
+	v := NewVerifier(key.Public().(ed25519.PublicKey))
+	var kinds []RecordKind
+	errs := 0
+	for _, l := range lines {
+		kind, err := v.Verify(l)
+		kinds = append(kinds, kind)
+		if err != nil {
+			errs++
+		}
+	}
+	return kinds, errs
+}
+
+func TestAppendSplitChain(t *testing.T) {
+	hash := nextHash(make([]byte, 32), []byte(`{"a":1}`))
+	line := appendChain([]byte(`{"a":1}`), 7, hash, nil, "")
+	assert.True(t, strings.HasPrefix(string(line), `{"a":1,"export_chain":{"index":"7","hash":"`))
+
+	record, chain, err := splitChain(line)
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":1}`, string(record))
+	assert.Equal(t, uint64(7), chain.Index)
+	decoded, err := decodeHash(chain.Hash)
+	require.NoError(t, err)
+	assert.Equal(t, hash, decoded)
+
+	line = appendChain([]byte("{}"), 7, hash, []byte("sig"), "id")
+	record, chain, err = splitChain(line)
+	require.NoError(t, err)
+	assert.Equal(t, "{}", string(record))
+	assert.Equal(t, []byte("sig"), chain.Signature)
+	assert.Equal(t, "id", chain.KeyId)
+
+	_, _, err = splitChain([]byte(`{"a":1}`))
+	assert.Error(t, err)
+}
+
+func TestWriterVerify(t *testing.T) {
+	key := newKey(t)
+	var buf bytes.Buffer
+	w, err := NewWriter(context.Background(), filepath.Join(t.TempDir(), "events.log"), nopCloser{&buf}, key, time.Hour)
+	require.NoError(t, err)
+
+	// records may be split across writes
+	_, err = w.Write([]byte(`{"a":1}` + "\n" + `{"a"`))
+	require.NoError(t, err)
+	_, err = w.Write([]byte(`:2}` + "\n"))
+	require.NoError(t, err)
+	require.NoError(t, w.Checkpoint())
+	writeRecords(t, w, `{"a":3}`)
+	require.NoError(t, w.Close())
+	_, err = w.Write([]byte(`{"a":4}` + "\n"))
+	assert.Error(t, err)
+
+	_, err = w.Write([]byte("not json\n"))
+	assert.Error(t, err)
+
+	all := lines(buf.Bytes())
+	require.Len(t, all, 5)
+	kinds, errs := verify(key, all)
+	assert.Zero(t, errs)
+	assert.Equal(t, []RecordKind{KindRecord, KindRecord, KindCheckpoint, KindRecord, KindCheckpoint}, kinds)
+
+	// checkpoints of other keys are rejected
+	_, errs = verify(newKey(t), all)
+	assert.Equal(t, 2, errs)
+}
+
+func TestVerifyTampering(t *testing.T) {
+	key := newKey(t)
+	var buf bytes.Buffer
+	w, err := NewWriter(context.Background(), filepath.Join(t.TempDir(), "events.log"), nopCloser{&buf}, key, time.Hour)
+	require.NoError(t, err)
+	writeRecords(t, w, `{"a":1}`, `{"a":2}`, `{"a":3}`)
+	require.NoError(t, w.Close())
+	all := lines(buf.Bytes())
+	require.Len(t, all, 4)
+
+	t.Run("modified record", func(t *testing.T) {
+		tampered := append([][]byte(nil), all...)
+		tampered[1] = bytes.Replace(all[1], []byte(`"a":2`), []byte(`"a":5`), 1)
+		_, errs := verify(key, tampered)
+		assert.NotZero(t, errs)
+	})
+	t.Run("removed record", func(t *testing.T) {
+		tampered := [][]byte{all[0], all[2], all[3]}
+		_, errs := verify(key, tampered)
+		assert.NotZero(t, errs)
+	})
+	t.Run("removed tail", func(t *testing.T) {
+		tampered := [][]byte{all[0], all[1], all[3]}
+		_, errs := verify(key, tampered)
+		assert.NotZero(t, errs)
+	})
+	t.Run("forged signature", func(t *testing.T) {
+		tampered := append([][]byte(nil), all...)
+		tampered[3] = bytes.Replace(all[3], []byte(`"signature":"`), []byte(`"signature":"AA`), 1)
+		_, errs := verify(key, tampered)
+		assert.NotZero(t, errs)
+	})
+	t.Run("missing beginning", func(t *testing.T) {
+		kinds, errs := verify(key, all[1:])
+		assert.Zero(t, errs)
+		assert.Equal(t, KindPartialChain, kinds[0])
+	})
+}
+
+func TestVerifyTruncatedRestart(t *testing.T) {
+	key := newKey(t)
+	path := filepath.Join(t.TempDir(), "events.log")
+	open := func() *os.File {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		require.NoError(t, err)
+		return f
+	}
+
+	w, err := NewWriter(context.Background(), path, open(), key, time.Hour)
+	require.NoError(t, err)
+	writeRecords(t, w, `{"a":1}`, `{"a":2}`)
+	require.NoError(t, w.Checkpoint())
+	writeRecords(t, w, `{"a":3}`)
+	require.NoError(t, w.Close())
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Len(t, lines(data), 5)
+
+	// the end of the file is removed, with its last checkpoint, and the
+	// agent restarts with a new chain
+	all := lines(data)
+	require.NoError(t, os.Truncate(path, int64(len(data)-len(all[4])-1)))
+	w, err = NewWriter(context.Background(), path, open(), key, time.Hour)
+	require.NoError(t, err)
+	writeRecords(t, w, `{"a":4}`)
+	require.NoError(t, w.Close())
+
+	// the records verify, only the new chain and the records that are not
+	// covered by a checkpoint show that the file was altered
+	data, err = ioutil.ReadFile(path)
+	require.NoError(t, err)
+	v := NewVerifier(key.Public().(ed25519.PublicKey))
+	var kinds []RecordKind
+	var unsigned []uint64
+	for _, l := range lines(data) {
+		unsigned = append(unsigned, v.Unsigned())
+		kind, err := v.Verify(l)
+		require.NoError(t, err)
+		kinds = append(kinds, kind)
+	}
+	assert.Equal(t, []RecordKind{KindRecord, KindRecord, KindCheckpoint, KindRecord, KindNewChain, KindCheckpoint}, kinds)
+	assert.Equal(t, uint64(1), unsigned[4])
+	assert.Zero(t, v.Unsigned())
+}
+
+func TestWriterResume(t *testing.T) {
+	key := newKey(t)
+	path := filepath.Join(t.TempDir(), "events.log")
+	open := func() *os.File {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		require.NoError(t, err)
+		return f
+	}
+
+	w, err := NewWriter(context.Background(), path, open(), key, time.Hour)
+	require.NoError(t, err)
+	writeRecords(t, w, `{"a":1}`)
+	require.NoError(t, w.Close())
+
+	// the file ends with a checkpoint, the chain continues
+	w, err = NewWriter(context.Background(), path, open(), key, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), w.index)
+	writeRecords(t, w, `{"a":2}`)
+	require.NoError(t, w.Close())
+
+	// records without a checkpoint, a new chain starts
+	f := open()
+	_, err = f.Write([]byte(appendChain([]byte(`{"a":3}`), 3, make([]byte, 32), nil, "")))
+	require.NoError(t, err)
+	_, err = f.Write([]byte("\n"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	w, err = NewWriter(context.Background(), path, open(), key, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), w.index)
+	writeRecords(t, w, `{"a":4}`)
+	require.NoError(t, w.Close())
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	kinds, errs := verify(key, lines(data))
+	assert.Equal(t, 1, errs)
+	assert.Equal(t, []RecordKind{KindRecord, KindCheckpoint, KindRecord, KindCheckpoint, KindRecord, KindNewChain, KindCheckpoint}, kinds)
+}
+
+func TestFiles(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "events.log")
+	_, err := Files(path)
+	require.NoError(t, err)
+
+	names := []string{
+		"events-2022-05-17T10-30-00.000.log.gz",
+		"events-2022-05-16T10-30-00.000.log",
+		"events.log",
+		"events-other.log",
+		"other-2022-05-16T10-30-00.000.log",
+	}
+	for _, name := range names {
+		var data []byte
+		if strings.HasSuffix(name, ".gz") {
+			var buf bytes.Buffer
+			zw := gzip.NewWriter(&buf)
+			zw.Write([]byte(name))
+			require.NoError(t, zw.Close())
+			data = buf.Bytes()
+		} else {
+			data = []byte(name)
+		}
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), data, 0600))
+	}
+
+	files, err := Files(path)
+	require.NoError(t, err)
+	var contents []string
+	for _, f := range files {
+		r, err := Open(f)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		contents = append(contents, string(data))
+	}
+	assert.Equal(t, []string{names[1], names[0], names[2]}, contents)
+}
+
+func TestLoadKeys(t *testing.T) {
+	dir := t.TempDir()
+	key := newKey(t)
+	privDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	pubDER, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+	privFile := filepath.Join(dir, "key.pem")
+	pubFile := filepath.Join(dir, "key.pub")
+	require.NoError(t, ioutil.WriteFile(privFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privDER}), 0600))
+	require.NoError(t, ioutil.WriteFile(pubFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER}), 0600))
+
+	loaded, err := LoadPrivateKey(privFile)
+	require.NoError(t, err)
+	assert.Equal(t, key, loaded)
+	for _, f := range []string{privFile, pubFile} {
+		pub, err := LoadPublicKey(f)
+		require.NoError(t, err)
+		assert.Equal(t, key.Public(), pub)
+	}
+
+	_, err = LoadPrivateKey(pubFile)
+	assert.Error(t, err)
+	_, err = LoadPrivateKey(filepath.Join(dir, "missing.pem"))
+	assert.Error(t, err)
+}
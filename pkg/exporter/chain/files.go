@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package chain
+
+import (
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// backupTimeFormat is the time format of the names of lumberjack
+	// backups, such as tetragon-2022-05-17T10-30-00.000.log.
+	backupTimeFormat = "2006-01-02T15-04-05.000"
+	gzipExt          = ".gz"
+)
+
+type backup struct {
+	path string
+	time time.Time
+}
+
+// Files returns the lumberjack backups of an export file, from the oldest to
+// the most recent, followed by the file itself if it exists.
+func Files(path string) ([]string, error) {
+	dir := filepath.Dir(path)
+	name := filepath.Base(path)
+	ext := filepath.Ext(name)
+	prefix := strings.TrimSuffix(name, ext) + "-"
+
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var backups []backup
+	for _, e := range entries {
+		fname := e.Name()
+		if e.IsDir() || !strings.HasPrefix(fname, prefix) {
+			continue
+		}
+		ts := strings.TrimSuffix(strings.TrimPrefix(fname, prefix), gzipExt)
+		if !strings.HasSuffix(ts, ext) {
+			continue
+		}
+		t, err := time.Parse(backupTimeFormat, strings.TrimSuffix(ts, ext))
+		if err != nil {
+			continue
+		}
+		backups = append(backups, backup{path: filepath.Join(dir, fname), time: t})
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].time.Before(backups[j].time)
+	})
+
+	var ret []string
+	for _, b := range backups {
+		ret = append(ret, b.path)
+	}
+	if _, err := os.Stat(path); err == nil {
+		ret = append(ret, path)
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type gzipFile struct {
+	*gzip.Reader
+	f *os.File
+}
+
+func (g *gzipFile) Close() error {
+	g.Reader.Close()
+	return g.f.Close()
+}
+
+// Open opens an export file, decompressing gzip backups.
+func Open(path string) (io.ReadCloser, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(path, gzipExt) {
+		return f, nil
+	}
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &gzipFile{Reader: r, f: f}, nil
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package chain
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"fmt"
+)
+
+// RecordKind is the kind of a verified record.
+type RecordKind int
+
+const (
+	// KindRecord is a record of the chain.
+	KindRecord RecordKind = iota
+	// KindCheckpoint is a valid checkpoint.
+	KindCheckpoint
+	// KindNewChain is the first record of a new chain, such as after an
+	// agent restart that did not resume the previous chain.
+	KindNewChain
+	// KindPartialChain is the first record that was verified, of a chain
+	// whose previous records were not given, for example because their
+	// files were removed by the rotation. The content of this record
+	// cannot be verified.
+	KindPartialChain
+)
+
+// Verifier verifies the records of export files, in order.
+type Verifier struct {
+	key   ed25519.PublicKey
+	keyID string
+
+	started bool
+	index   uint64
+	hash    []byte
+	signed  uint64
+}
+
+// NewVerifier creates a verifier of the checkpoints of key.
+func NewVerifier(key ed25519.PublicKey) *Verifier {
+	return &Verifier{key: key, keyID: KeyID(key)}
+}
+
+// Unsigned returns the number of records of the current chain after its last
+// checkpoint, which are not protected.
+func (v *Verifier) Unsigned() uint64 {
+	return v.index - v.signed
+}
+
+// Index returns the index of the last record.
+func (v *Verifier) Index() uint64 {
+	return v.index
+}
+
+// Verify verifies the next record, a line of an export file. It returns an
+// error if the record does not continue the chain, or if it is a checkpoint
+// with an invalid signature. The verification continues from the record
+// after an error.
+func (v *Verifier) Verify(line []byte) (RecordKind, error) {
+	record, chain, err := splitChain(line)
+	if err != nil {
+		return KindRecord, err
+	}
+	hash, err := decodeHash(chain.Hash)
+	if err != nil {
+		return KindRecord, err
+	}
+
+	if chain.Signature != nil {
+		if chain.KeyId != v.keyID {
+			return KindCheckpoint, fmt.Errorf("checkpoint is signed by key %s, not %s", chain.KeyId, v.keyID)
+		}
+		if !ed25519.Verify(v.key, signedMessage(chain.Index, hash), chain.Signature) {
+			return KindCheckpoint, fmt.Errorf("checkpoint of record %d has an invalid signature", chain.Index)
+		}
+		if !v.started {
+			// the checkpoint signs records that were not given
+			v.started, v.index, v.hash, v.signed = true, chain.Index, hash, chain.Index
+			return KindCheckpoint, nil
+		}
+		if chain.Index != v.index || !bytes.Equal(hash, v.hash) {
+			return KindCheckpoint, fmt.Errorf("checkpoint of record %d does not match record %d", chain.Index, v.index)
+		}
+		v.signed = v.index
+		return KindCheckpoint, nil
+	}
+
+	kind := KindRecord
+	prev := v.hash
+	switch {
+	case chain.Index == 1:
+		prev = make([]byte, len(hash))
+		if v.started {
+			kind = KindNewChain
+		}
+		v.signed = 0
+	case !v.started:
+		// the hash of the previous record is unknown, trust this one
+		v.started, v.index, v.hash, v.signed = true, chain.Index, hash, chain.Index-1
+		return KindPartialChain, nil
+	case chain.Index != v.index+1:
+		err = fmt.Errorf("record %d follows record %d", chain.Index, v.index)
+	}
+	if err == nil && !bytes.Equal(hash, nextHash(prev, record)) {
+		err = fmt.Errorf("record %d does not match its hash", chain.Index)
+	}
+	v.started, v.index, v.hash = true, chain.Index, hash
+	return kind, err
+}
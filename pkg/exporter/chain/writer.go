@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package chain
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/pkg/logger"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	DefaultCheckpointInterval = time.Minute
+
+	// tailSize is the size of the end of the export file that is read to
+	// find the last checkpoint.
+	tailSize = 1024 * 1024
+)
+
+// Writer adds the hash chain to the JSON records written to an export file,
+// one per line, and writes the checkpoints.
+type Writer struct {
+	dst   io.WriteCloser
+	key   ed25519.PrivateKey
+	keyID string
+	log   logrus.FieldLogger
+
+	mu     sync.Mutex
+	closed bool
+	// index and hash of the last record, and index of the last checkpoint
+	index  uint64
+	hash   []byte
+	signed uint64
+	// partial is the beginning of a record that was not terminated yet
+	partial []byte
+
+	stop      chan struct{}
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// NewWriter creates a writer that writes to dst, the export file at path. If
+// the file, or its most recent backup, ends with a checkpoint of the key, the
+// chain continues from there, so that it survives restarts. Otherwise, a new
+// chain starts, so that records that were not signed are never signed later.
+// A checkpoint is written every interval until ctx is done, and when the
+// writer, which the caller must close, is closed, before dst is closed.
+func NewWriter(ctx context.Context, path string, dst io.WriteCloser, key ed25519.PrivateKey, interval time.Duration) (*Writer, error) {
+	if interval <= 0 {
+		interval = DefaultCheckpointInterval
+	}
+	w := &Writer{
+		dst:   dst,
+		key:   key,
+		keyID: KeyID(key.Public().(ed25519.PublicKey)),
+		log:   logger.GetLogger().WithField("filename", path),
+		hash:  make([]byte, 32),
+		stop:  make(chan struct{}),
+	}
+	index, hash, err := lastCheckpoint(path, key.Public().(ed25519.PublicKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to resume the hash chain of %s: %w", path, err)
+	}
+	if index > 0 {
+		w.index, w.hash, w.signed = index, hash, index
+		w.log.WithField("index", index).Info("Resuming export hash chain")
+	} else {
+		w.log.Info("Starting export hash chain")
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := w.Checkpoint(); err != nil {
+					w.log.WithError(err).Warn("Failed to write export checkpoint")
+				}
+			case <-ctx.Done():
+				return
+			case <-w.stop:
+				return
+			}
+		}
+	}()
+	return w, nil
+}
+
+// lastCheckpoint returns the index and hash of the last checkpoint of an
+// export file, or of its most recent backup if it is empty, if it is the last
+// record and is signed by key.
+func lastCheckpoint(path string, key ed25519.PublicKey) (uint64, []byte, error) {
+	files, err := Files(path)
+	if err != nil {
+		return 0, nil, err
+	}
+	for i := len(files) - 1; i >= 0; i-- {
+		line, err := lastLine(files[i])
+		if err != nil {
+			return 0, nil, err
+		}
+		if line == nil {
+			continue
+		}
+		_, chain, err := splitChain(line)
+		if err != nil || chain.Signature == nil || chain.KeyId != KeyID(key) {
+			return 0, nil, nil
+		}
+		hash, err := decodeHash(chain.Hash)
+		if err != nil || !ed25519.Verify(key, signedMessage(chain.Index, hash), chain.Signature) {
+			return 0, nil, nil
+		}
+		return chain.Index, hash, nil
+	}
+	return 0, nil, nil
+}
+
+// lastLine returns the last complete line of a file.
+func lastLine(path string) ([]byte, error) {
+	f, err := Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if osFile, ok := f.(*os.File); ok {
+		info, err := osFile.Stat()
+		if err != nil {
+			return nil, err
+		}
+		if info.Size() > tailSize {
+			if _, err := osFile.Seek(info.Size()-tailSize, io.SeekStart); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var last []byte
+	r := bufio.NewReader(f)
+	for {
+		line, err := r.ReadBytes('\n')
+		if bytes.HasSuffix(line, []byte("\n")) && len(bytes.TrimSpace(line)) > 0 {
+			last = line
+		}
+		if errors.Is(err, io.EOF) {
+			return last, nil
+		} else if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Write adds the records of p to the chain, and writes them.
+func (w *Writer) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return 0, io.ErrClosedPipe
+	}
+	data := append(w.partial, p...)
+	w.partial = nil
+	index, hash := w.index, w.hash
+	var out []byte
+	for len(data) > 0 {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			w.partial = append([]byte(nil), data...)
+			break
+		}
+		line := bytes.TrimSpace(data[:i])
+		data = data[i+1:]
+		if len(line) == 0 {
+			continue
+		}
+		if line[0] != '{' || line[len(line)-1] != '}' {
+			return 0, errors.New("hash chains require JSON records")
+		}
+		index++
+		hash = nextHash(hash, line)
+		out = append(out, appendChain(line, index, hash, nil, "")...)
+		out = append(out, '\n')
+	}
+	if len(out) > 0 {
+		if _, err := w.dst.Write(out); err != nil {
+			return 0, err
+		}
+	}
+	w.index, w.hash = index, hash
+	return len(p), nil
+}
+
+// Checkpoint signs the records written since the previous checkpoint.
+func (w *Writer) Checkpoint() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return io.ErrClosedPipe
+	}
+	return w.checkpointLocked()
+}
+
+func (w *Writer) checkpointLocked() error {
+	if w.index == w.signed {
+		return nil
+	}
+	signature := ed25519.Sign(w.key, signedMessage(w.index, w.hash))
+	line := appendChain([]byte("{}"), w.index, w.hash, signature, w.keyID)
+	if _, err := w.dst.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	w.signed = w.index
+	return nil
+}
+
+// Close writes a last checkpoint and closes the destination.
+func (w *Writer) Close() error {
+	w.closeOnce.Do(func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		w.closed = true
+		close(w.stop)
+		w.closeErr = w.checkpointLocked()
+		if err := w.dst.Close(); err != nil && w.closeErr == nil {
+			w.closeErr = err
+		}
+	})
+	return w.closeErr
+}
@@ -0,0 +1,490 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package exporter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/isovalent/tetragon-oss/pkg/aggregator"
+	"github.com/isovalent/tetragon-oss/pkg/encoder"
+	"github.com/isovalent/tetragon-oss/pkg/exporter/chain"
+	"github.com/isovalent/tetragon-oss/pkg/exporter/otlp"
+	"github.com/isovalent/tetragon-oss/pkg/exporter/protobuf"
+	"github.com/isovalent/tetragon-oss/pkg/exporter/spool"
+	"github.com/isovalent/tetragon-oss/pkg/exporter/syslog"
+	"github.com/isovalent/tetragon-oss/pkg/fieldmask"
+	"github.com/isovalent/tetragon-oss/pkg/filters"
+	"github.com/isovalent/tetragon-oss/pkg/logger"
+	"github.com/isovalent/tetragon-oss/pkg/ratelimit"
+	"github.com/isovalent/tetragon-oss/pkg/server"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	EncodingJSON     = "json"
+	EncodingSyslog   = "syslog"
+	EncodingProtobuf = "protobuf"
+	EncodingECS      = "ecs"
+	EncodingOCSF     = "ocsf"
+)
+
+// NewEncoderFunc creates an encoder that writes the events of an exporter to
+// w. The configuration of the exporter has been validated.
+type NewEncoderFunc func(w io.Writer, cfg *Config) (ExportEncoder, error)
+
+var encoders = map[string]NewEncoderFunc{
+	EncodingJSON: func(w io.Writer, _ *Config) (ExportEncoder, error) {
+		return json.NewEncoder(w), nil
+	},
+	EncodingSyslog: newSyslogEncoder,
+	EncodingProtobuf: func(w io.Writer, cfg *Config) (ExportEncoder, error) {
+		return protobuf.NewEncoder(w, cfg.Protobuf)
+	},
+	EncodingECS: func(w io.Writer, _ *Config) (ExportEncoder, error) {
+		return encoder.NewECSEncoder(w), nil
+	},
+	EncodingOCSF: func(w io.Writer, _ *Config) (ExportEncoder, error) {
+		return encoder.NewOCSFEncoder(w), nil
+	},
+}
+
+// newSyslogEncoder creates a syslog encoder, whose messages are framed for
+// the sink by default.
+func newSyslogEncoder(w io.Writer, cfg *Config) (ExportEncoder, error) {
+	framing := syslog.FramingNone
+	switch {
+	case cfg.Sink.Type == SinkTypeFile || cfg.Sink.Type == SinkTypeStdout:
+		framing = syslog.FramingNewline
+	case isStreamSink(cfg.Sink.Type):
+		framing = syslog.FramingOctetCounting
+	}
+	return syslog.NewEncoder(w, cfg.Syslog, framing)
+}
+
+// RegisterEncoder makes an encoding available to the exporters. It is meant
+// to be called from init functions.
+func RegisterEncoder(name string, fn NewEncoderFunc) {
+	encoders[name] = fn
+}
+
+// SinkConfig configures the destination of the events of an exporter.
+type SinkConfig struct {
+	// Type is one of file, stdout, unix, unixgram, tcp, tls, udp or otlp.
+	Type string `json:"type"`
+	// Path of the file of file sinks, and of the socket of unix and
+	// unixgram sinks.
+	Path string `json:"path,omitempty"`
+	// Address of tcp, tls and udp sinks, as host:port.
+	Address string `json:"address,omitempty"`
+	// TLS configures tls sinks.
+	TLS *SinkTLSConfig `json:"tls,omitempty"`
+
+	// Rotation options of file sinks.
+	MaxSizeMB        int             `json:"maxSizeMB,omitempty"`
+	MaxBackups       int             `json:"maxBackups,omitempty"`
+	Compress         bool            `json:"compress,omitempty"`
+	RotationInterval metav1.Duration `json:"rotationInterval,omitempty"`
+
+	// OTLP configures otlp sinks, which export the events as OpenTelemetry
+	// log records to a collector.
+	OTLP *otlp.Config `json:"otlp,omitempty"`
+
+	// Spool keeps the events on disk while socket sinks are unavailable,
+	// and sends them once the sinks recover. For otlp sinks, the events are
+	// kept until they are queued for export, i.e. while the queue is full
+	// because the collector is slow or unavailable. The events that were
+	// queued and not exported yet are not kept when the agent stops.
+	Spool *SpoolConfig `json:"spool,omitempty"`
+
+	// HashChain makes the JSON records of file sinks tamper-evident.
+	HashChain *HashChainConfig `json:"hashChain,omitempty"`
+}
+
+// HashChainConfig configures the hash chain of a file sink, see package chain.
+type HashChainConfig struct {
+	// KeyFile is the Ed25519 private key (PKCS #8 PEM) that signs the
+	// checkpoints.
+	KeyFile string `json:"keyFile"`
+	// CheckpointInterval is the interval between checkpoints. Defaults to
+	// 1m.
+	CheckpointInterval metav1.Duration `json:"checkpointInterval,omitempty"`
+}
+
+// SpoolConfig configures the disk spool of a sink.
+type SpoolConfig struct {
+	// Dir is the directory of the spool. It must not be shared with other
+	// exporters.
+	Dir string `json:"dir"`
+	// MaxSizeMB is the maximum size of the spool. When it is reached, the
+	// oldest events are dropped. Defaults to 100.
+	MaxSizeMB int `json:"maxSizeMB,omitempty"`
+	// SegmentSizeMB is the size of the files of the spool. Defaults to 8.
+	SegmentSizeMB int `json:"segmentSizeMB,omitempty"`
+}
+
+func (c *SpoolConfig) options() spool.Options {
+	return spool.Options{
+		MaxSize:     int64(c.MaxSizeMB) * 1024 * 1024,
+		SegmentSize: int64(c.SegmentSizeMB) * 1024 * 1024,
+	}
+}
+
+// Config configures an exporter. Each exporter gets the events from its own
+// GetEvents listener, so that the filters, field masks and rate limits of an
+// exporter do not affect the others.
+type Config struct {
+	Name string     `json:"name"`
+	Sink SinkConfig `json:"sink"`
+	// Encoding of the events, json (default), syslog, protobuf, ecs (Elastic
+	// Common Schema) or ocsf (Open Cybersecurity Schema Framework). It is
+	// not used by otlp sinks, which have their own encoding.
+	Encoding string `json:"encoding,omitempty"`
+	// Syslog configures the syslog encoding.
+	Syslog *syslog.Config `json:"syslog,omitempty"`
+	// Protobuf configures the protobuf encoding, which writes
+	// length-delimited GetEventsResponse messages that tetra export read
+	// prints.
+	Protobuf  *protobuf.Config      `json:"protobuf,omitempty"`
+	AllowList []*fgs.Filter         `json:"allowList,omitempty"`
+	DenyList  []*fgs.Filter         `json:"denyList,omitempty"`
+	FieldMask []*fgs.EventFieldMask `json:"fieldMask,omitempty"`
+	// RateLimit is the number of events exported per minute. The export is
+	// not rate limited if it is not set or negative.
+	RateLimit *int `json:"rateLimit,omitempty"`
+	// BufferSize and DropPolicy configure the listener of the exporter,
+	// see GetEventsRequest.
+	BufferSize uint32 `json:"bufferSize,omitempty"`
+	// DropPolicy is one of drop-oldest (default), drop-newest or
+	// disconnect.
+	DropPolicy  string                  `json:"dropPolicy,omitempty"`
+	Aggregation *fgs.AggregationOptions `json:"aggregation,omitempty"`
+}
+
+// FileConfig is the format of exporter configuration files.
+type FileConfig struct {
+	Exporters []Config `json:"exporters"`
+}
+
+// ReadConfigFile reads the exporter configurations of a YAML file.
+func ReadConfigFile(fname string) ([]Config, error) {
+	data, err := ioutil.ReadFile(fname)
+	if err != nil {
+		return nil, err
+	}
+	var cfg FileConfig
+	if err := yaml.UnmarshalStrict(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", fname, err)
+	}
+	return cfg.Exporters, nil
+}
+
+// ParseDropPolicy parses a listener drop policy. The empty string is the
+// default policy.
+func ParseDropPolicy(policy string) (fgs.ListenerDropPolicy, error) {
+	switch policy {
+	case "", "drop-oldest":
+		return fgs.ListenerDropPolicy_LISTENER_DROP_POLICY_DROP_OLDEST, nil
+	case "drop-newest":
+		return fgs.ListenerDropPolicy_LISTENER_DROP_POLICY_DROP_NEWEST, nil
+	case "disconnect":
+		return fgs.ListenerDropPolicy_LISTENER_DROP_POLICY_DISCONNECT, nil
+	}
+	return 0, fmt.Errorf("invalid drop policy %q", policy)
+}
+
+func (c *Config) validateSink() error {
+	if c.Sink.TLS != nil && c.Sink.Type != SinkTypeTLS {
+		return fmt.Errorf("%s sink does not support tls options", c.Sink.Type)
+	}
+	if c.Sink.HashChain != nil {
+		if c.Sink.Type != SinkTypeFile {
+			return fmt.Errorf("%s sink does not support hash chains", c.Sink.Type)
+		}
+		switch c.encoding() {
+		case EncodingJSON, EncodingECS, EncodingOCSF:
+		default:
+			return fmt.Errorf("hash chains require a JSON encoding, not %s", c.encoding())
+		}
+		if _, err := chain.LoadPrivateKey(c.Sink.HashChain.KeyFile); err != nil {
+			return fmt.Errorf("invalid hash chain key: %w", err)
+		}
+	}
+	if c.Sink.Spool != nil {
+		switch c.Sink.Type {
+		case SinkTypeUnix, SinkTypeUnixgram, SinkTypeTCP, SinkTypeTLS, SinkTypeUDP, SinkTypeOTLP:
+		default:
+			return fmt.Errorf("%s sink does not support spooling", c.Sink.Type)
+		}
+		if c.Sink.Spool.Dir == "" {
+			return fmt.Errorf("spool requires a directory")
+		}
+		if c.Sink.Spool.MaxSizeMB < 0 || c.Sink.Spool.SegmentSizeMB < 0 {
+			return fmt.Errorf("spool sizes must not be negative")
+		}
+	}
+	switch c.Sink.Type {
+	case SinkTypeFile, SinkTypeUnix, SinkTypeUnixgram:
+		if c.Sink.Path == "" {
+			return fmt.Errorf("%s sink requires a path", c.Sink.Type)
+		}
+	case SinkTypeTCP, SinkTypeTLS, SinkTypeUDP:
+		if c.Sink.Address == "" {
+			return fmt.Errorf("%s sink requires an address", c.Sink.Type)
+		}
+		if c.Sink.Type == SinkTypeTLS {
+			if _, err := c.Sink.TLS.tlsConfig(); err != nil {
+				return err
+			}
+		}
+	case SinkTypeOTLP:
+		if c.Sink.OTLP == nil {
+			return fmt.Errorf("%s sink requires otlp options", c.Sink.Type)
+		}
+		if c.Encoding != "" {
+			return fmt.Errorf("%s sink does not support encodings", c.Sink.Type)
+		}
+		return c.Sink.OTLP.Validate()
+	case SinkTypeStdout:
+	default:
+		return fmt.Errorf("unknown sink type %q", c.Sink.Type)
+	}
+	return nil
+}
+
+// request validates the configuration and returns the GetEvents request of
+// the exporter. Errors are reported here, rather than by the GetEvents call
+// of a running exporter.
+func (c *Config) request() (*fgs.GetEventsRequest, error) {
+	if err := c.validateSink(); err != nil {
+		return nil, err
+	}
+	if _, ok := encoders[c.encoding()]; !ok && c.Sink.Type != SinkTypeOTLP {
+		return nil, fmt.Errorf("unknown encoding %q", c.Encoding)
+	}
+	if c.Protobuf != nil {
+		if c.encoding() != EncodingProtobuf {
+			return nil, fmt.Errorf("protobuf options require the %s encoding", EncodingProtobuf)
+		}
+		if err := c.Protobuf.Validate(); err != nil {
+			return nil, err
+		}
+		if c.Protobuf.Compression == protobuf.CompressionZstd && !isStreamSink(c.Sink.Type) {
+			return nil, fmt.Errorf("%s sink does not support compression", c.Sink.Type)
+		}
+	}
+	if c.Syslog != nil {
+		if c.encoding() != EncodingSyslog {
+			return nil, fmt.Errorf("syslog options require the %s encoding", EncodingSyslog)
+		}
+		if err := c.Syslog.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := filters.BuildFilterList(context.Background(), c.AllowList, filters.Filters); err != nil {
+		return nil, fmt.Errorf("invalid allow list: %w", err)
+	}
+	if _, err := filters.BuildFilterList(context.Background(), c.DenyList, filters.Filters); err != nil {
+		return nil, fmt.Errorf("invalid deny list: %w", err)
+	}
+	if _, err := fieldmask.New(c.FieldMask); err != nil {
+		return nil, fmt.Errorf("invalid field mask: %w", err)
+	}
+	dropPolicy, err := ParseDropPolicy(c.DropPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if c.Aggregation != nil {
+		if err := aggregator.CheckOptions(c.Aggregation); err != nil {
+			return nil, fmt.Errorf("invalid aggregation options: %w", err)
+		}
+	}
+	return &fgs.GetEventsRequest{
+		AllowList:          c.AllowList,
+		DenyList:           c.DenyList,
+		AggregationOptions: c.Aggregation,
+		FieldMask:          c.FieldMask,
+		BufferSize:         c.BufferSize,
+		DropPolicy:         dropPolicy,
+	}, nil
+}
+
+func (c *Config) encoding() string {
+	if c.Sink.Type == SinkTypeOTLP {
+		return SinkTypeOTLP
+	}
+	if c.Encoding == "" {
+		return EncodingJSON
+	}
+	return c.Encoding
+}
+
+// newEncoder creates the encoder of the exporter, which writes the events to
+// its sink. The exporter closes the encoder when it stops.
+func (c *Config) newEncoder(ctx context.Context) (*sinkEncoder, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	var sink Sink
+	if c.Sink.Type == SinkTypeOTLP {
+		exporter, err := otlp.New(ctx, c.Sink.OTLP)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		if c.Sink.Spool == nil {
+			return &sinkEncoder{ExportEncoder: exporter, cancel: cancel}, nil
+		}
+		// Spooled events are written to the exporter, which queues them
+		// for export, see otlp.Exporter.Write.
+		sink = exporter
+	} else {
+		var err error
+		sink, err = openSink(ctx, &c.Sink)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	if c.Sink.Spool != nil {
+		w, err := spool.NewWriter(ctx, c.Name, c.Sink.Spool.Dir, c.Sink.Spool.options(), sink, sinkRetryInterval)
+		if err != nil {
+			sink.Close()
+			cancel()
+			return nil, err
+		}
+		sink = w
+	}
+	if c.Sink.HashChain != nil {
+		w, err := newHashChainWriter(ctx, &c.Sink, sink)
+		if err != nil {
+			sink.Close()
+			cancel()
+			return nil, err
+		}
+		sink = w
+	}
+	encoderFunc := encoders[c.encoding()]
+	if c.Sink.Type == SinkTypeOTLP {
+		// events are spooled as protobuf messages
+		encoderFunc = encoders[EncodingProtobuf]
+	}
+	encoder, err := encoderFunc(sink, c)
+	if err != nil {
+		sink.Close()
+		cancel()
+		return nil, fmt.Errorf("failed to create %s encoder: %w", c.encoding(), err)
+	}
+	return &sinkEncoder{ExportEncoder: encoder, sink: sink, cancel: cancel}, nil
+}
+
+// sinkEncoder is the encoder of an exporter, with the sink that it writes to.
+type sinkEncoder struct {
+	ExportEncoder
+	sink   Sink
+	cancel context.CancelFunc
+}
+
+// Close flushes the encoder, if it buffers events, then closes the sink and
+// stops the goroutines of the encoder. The exporter closes the encoder once
+// it stopped encoding, so that the buffered events and the last checkpoint
+// of hash chains are written before the sink is closed. Encoders without a
+// sink, such as OTLP, are closed instead, which waits for their last export.
+func (e *sinkEncoder) Close() error {
+	defer e.cancel()
+	var err error
+	if f, ok := e.ExportEncoder.(flusher); ok {
+		err = f.Flush()
+	}
+	if c, ok := e.ExportEncoder.(io.Closer); ok && e.sink == nil {
+		err = c.Close()
+	}
+	if e.sink != nil {
+		if closeErr := e.sink.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+func newHashChainWriter(ctx context.Context, cfg *SinkConfig, sink Sink) (Sink, error) {
+	key, err := chain.LoadPrivateKey(cfg.HashChain.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	return chain.NewWriter(ctx, cfg.Path, sink, key, cfg.HashChain.CheckpointInterval.Duration)
+}
+
+type flusher interface {
+	Flush() error
+}
+
+// StartExporters starts an exporter for each configuration. All the
+// configurations are validated, and all the encoders created, before any
+// exporter is started.
+func StartExporters(ctx context.Context, server *server.Server, configs []Config) error {
+	names := map[string]bool{}
+	spoolDirs := map[string]string{}
+	requests := make([]*fgs.GetEventsRequest, len(configs))
+	for i := range configs {
+		cfg := &configs[i]
+		if cfg.Name == "" {
+			return fmt.Errorf("exporter %d has no name", i)
+		}
+		if names[cfg.Name] {
+			return fmt.Errorf("duplicate exporter name %q", cfg.Name)
+		}
+		names[cfg.Name] = true
+		req, err := cfg.request()
+		if err != nil {
+			return fmt.Errorf("exporter %q: %w", cfg.Name, err)
+		}
+		if cfg.Sink.Spool != nil {
+			dir := filepath.Clean(cfg.Sink.Spool.Dir)
+			if other, ok := spoolDirs[dir]; ok {
+				return fmt.Errorf("exporters %q and %q share the spool directory %s", other, cfg.Name, dir)
+			}
+			spoolDirs[dir] = cfg.Name
+		}
+		requests[i] = req
+	}
+
+	// the encoders are all created before any exporter starts, so that an
+	// exporter does not start when another one fails
+	exportEncoders := make([]*sinkEncoder, 0, len(configs))
+	for i := range configs {
+		cfg := &configs[i]
+		encoder, err := cfg.newEncoder(ctx)
+		if err != nil {
+			for _, encoder := range exportEncoders {
+				encoder.Close()
+			}
+			return fmt.Errorf("exporter %q: %w", cfg.Name, err)
+		}
+		exportEncoders = append(exportEncoders, encoder)
+	}
+
+	for i := range configs {
+		cfg := &configs[i]
+		listener := "exporter/" + cfg.Name
+		var rateLimiter *ratelimit.RateLimiter
+		if cfg.RateLimit != nil && *cfg.RateLimit >= 0 {
+			rateLimiter = ratelimit.NewRateLimiter(ctx, 1*time.Minute, *cfg.RateLimit, cfg.Name, listener, server)
+		}
+		logger.GetLogger().WithFields(logrus.Fields{
+			"exporter": cfg.Name,
+			"sink":     cfg.Sink.Type,
+			"encoding": cfg.encoding(),
+			"request":  requests[i],
+		}).Info("Starting exporter")
+		NewExporter(ctx, listener, requests[i], server, exportEncoders[i], rateLimiter).Start()
+	}
+	return nil
+}
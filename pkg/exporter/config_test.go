@@ -0,0 +1,334 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/isovalent/tetragon-oss/pkg/exporter/chain"
+	"github.com/isovalent/tetragon-oss/pkg/exporter/otlp"
+	"github.com/isovalent/tetragon-oss/pkg/exporter/protobuf"
+	"github.com/isovalent/tetragon-oss/pkg/exporter/syslog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func writeConfigFile(t *testing.T, data string) string {
+	fname := filepath.Join(t.TempDir(), "exporters.yaml")
+	require.NoError(t, os.WriteFile(fname, []byte(data), 0o600))
+	return fname
+}
+
+func TestReadConfigFile(t *testing.T) {
+	fname := writeConfigFile(t, `
+exporters:
+- name: file
+  sink:
+    type: file
+    path: /var/run/tetragon/events.log
+    maxSizeMB: 20
+    rotationInterval: 1h
+  allowList:
+  - event_set: [PROCESS_EXEC]
+  fieldMask:
+  - event_set: [PROCESS_EXEC]
+    include: process.binary
+  rateLimit: 100
+  dropPolicy: drop-newest
+- name: siem
+  sink:
+    type: tcp
+    address: siem.example.com:5000
+    spool:
+      dir: /var/lib/tetragon/spool/siem
+      maxSizeMB: 500
+  aggregation:
+    window_size: 10s
+- name: otel
+  sink:
+    type: otlp
+    otlp:
+      endpoint: otel-collector:4317
+      insecure: true
+      headers:
+        authorization: secret
+      batchTimeout: 1s
+- name: siem-syslog
+  sink:
+    type: tls
+    address: siem.example.com:6514
+    tls:
+      serverName: siem.example.com
+  encoding: syslog
+  syslog:
+    format: cef
+    severity:
+      policies:
+        sensitive-files: alert
+`)
+	configs, err := ReadConfigFile(fname)
+	require.NoError(t, err)
+	require.Len(t, configs, 4)
+
+	file := configs[0]
+	assert.Equal(t, "file", file.Name)
+	assert.Equal(t, SinkTypeFile, file.Sink.Type)
+	assert.Equal(t, "/var/run/tetragon/events.log", file.Sink.Path)
+	assert.Equal(t, 20, file.Sink.MaxSizeMB)
+	assert.Equal(t, time.Hour, file.Sink.RotationInterval.Duration)
+	require.Len(t, file.AllowList, 1)
+	assert.Equal(t, []fgs.EventType{fgs.EventType_PROCESS_EXEC}, file.AllowList[0].EventSet)
+	require.Len(t, file.FieldMask, 1)
+	assert.Equal(t, []string{"process.binary"}, file.FieldMask[0].Include.GetPaths())
+	require.NotNil(t, file.RateLimit)
+	assert.Equal(t, 100, *file.RateLimit)
+	req, err := file.request()
+	require.NoError(t, err)
+	assert.Equal(t, fgs.ListenerDropPolicy_LISTENER_DROP_POLICY_DROP_NEWEST, req.DropPolicy)
+
+	siem := configs[1]
+	assert.Equal(t, SinkTypeTCP, siem.Sink.Type)
+	assert.Equal(t, "siem.example.com:5000", siem.Sink.Address)
+	require.NotNil(t, siem.Sink.Spool)
+	assert.Equal(t, "/var/lib/tetragon/spool/siem", siem.Sink.Spool.Dir)
+	assert.Equal(t, int64(500*1024*1024), siem.Sink.Spool.options().MaxSize)
+	assert.Nil(t, siem.RateLimit)
+	require.NotNil(t, siem.Aggregation)
+	assert.Equal(t, 10*time.Second, siem.Aggregation.WindowSize.AsDuration())
+	_, err = siem.request()
+	require.NoError(t, err)
+
+	otel := configs[2]
+	assert.Equal(t, SinkTypeOTLP, otel.Sink.Type)
+	require.NotNil(t, otel.Sink.OTLP)
+	assert.Equal(t, "otel-collector:4317", otel.Sink.OTLP.Endpoint)
+	assert.True(t, otel.Sink.OTLP.Insecure)
+	assert.Equal(t, map[string]string{"authorization": "secret"}, otel.Sink.OTLP.Headers)
+	assert.Equal(t, time.Second, otel.Sink.OTLP.BatchTimeout.Duration)
+	_, err = otel.request()
+	require.NoError(t, err)
+
+	siemSyslog := configs[3]
+	assert.Equal(t, SinkTypeTLS, siemSyslog.Sink.Type)
+	assert.Equal(t, "siem.example.com", siemSyslog.Sink.TLS.ServerName)
+	assert.Equal(t, EncodingSyslog, siemSyslog.Encoding)
+	require.NotNil(t, siemSyslog.Syslog)
+	assert.Equal(t, syslog.FormatCEF, siemSyslog.Syslog.Format)
+	assert.Equal(t, map[string]string{"sensitive-files": "alert"}, siemSyslog.Syslog.Severity.Policies)
+	_, err = siemSyslog.request()
+	require.NoError(t, err)
+}
+
+func TestReadConfigFileUnknownField(t *testing.T) {
+	fname := writeConfigFile(t, `
+exporters:
+- name: file
+  sink:
+    type: file
+    filename: /var/run/tetragon/events.log
+`)
+	_, err := ReadConfigFile(fname)
+	assert.Error(t, err)
+}
+
+func TestConfigValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+	}{
+		{"unknown sink", Config{Sink: SinkConfig{Type: "kafka"}}},
+		{"file without path", Config{Sink: SinkConfig{Type: SinkTypeFile}}},
+		{"unix without path", Config{Sink: SinkConfig{Type: SinkTypeUnix}}},
+		{"tcp without address", Config{Sink: SinkConfig{Type: SinkTypeTCP}}},
+		{"otlp without options", Config{Sink: SinkConfig{Type: SinkTypeOTLP}}},
+		{"otlp without endpoint", Config{Sink: SinkConfig{Type: SinkTypeOTLP, OTLP: &otlp.Config{}}}},
+		{"otlp with encoding", Config{
+			Sink:     SinkConfig{Type: SinkTypeOTLP, OTLP: &otlp.Config{Endpoint: "localhost:4317"}},
+			Encoding: EncodingJSON,
+		}},
+		{"unknown encoding", Config{Sink: SinkConfig{Type: SinkTypeStdout}, Encoding: "xml"}},
+		{"syslog options without syslog encoding", Config{Sink: SinkConfig{Type: SinkTypeStdout}, Syslog: &syslog.Config{}}},
+		{"invalid syslog options", Config{
+			Sink:     SinkConfig{Type: SinkTypeStdout},
+			Encoding: EncodingSyslog,
+			Syslog:   &syslog.Config{Facility: "local9"},
+		}},
+		{"protobuf options without protobuf encoding", Config{Sink: SinkConfig{Type: SinkTypeStdout}, Protobuf: &protobuf.Config{}}},
+		{"invalid protobuf options", Config{
+			Sink:     SinkConfig{Type: SinkTypeStdout},
+			Encoding: EncodingProtobuf,
+			Protobuf: &protobuf.Config{Compression: "lz4"},
+		}},
+		{"compression on datagram sink", Config{
+			Sink:     SinkConfig{Type: SinkTypeUDP, Address: "localhost:514"},
+			Encoding: EncodingProtobuf,
+			Protobuf: &protobuf.Config{Compression: protobuf.CompressionZstd},
+		}},
+		{"tls options without tls", Config{Sink: SinkConfig{Type: SinkTypeTCP, Address: "localhost:514", TLS: &SinkTLSConfig{}}}},
+		{"udp without address", Config{Sink: SinkConfig{Type: SinkTypeUDP}}},
+		{"spool on file sink", Config{Sink: SinkConfig{Type: SinkTypeFile, Path: "/tmp/a", Spool: &SpoolConfig{Dir: "/tmp/spool"}}}},
+		{"spool without directory", Config{Sink: SinkConfig{Type: SinkTypeTCP, Address: "localhost:514", Spool: &SpoolConfig{}}}},
+		{"negative spool size", Config{Sink: SinkConfig{Type: SinkTypeTCP, Address: "localhost:514", Spool: &SpoolConfig{Dir: "/tmp/spool", MaxSizeMB: -1}}}},
+		{"hash chain on tcp sink", Config{Sink: SinkConfig{Type: SinkTypeTCP, Address: "localhost:514", HashChain: &HashChainConfig{KeyFile: "/tmp/key.pem"}}}},
+		{"hash chain with protobuf encoding", Config{
+			Sink:     SinkConfig{Type: SinkTypeFile, Path: "/tmp/a", HashChain: &HashChainConfig{KeyFile: "/tmp/key.pem"}},
+			Encoding: EncodingProtobuf,
+		}},
+		{"hash chain without key", Config{Sink: SinkConfig{Type: SinkTypeFile, Path: "/tmp/a", HashChain: &HashChainConfig{KeyFile: "/nonexistent/key.pem"}}}},
+		{"unknown drop policy", Config{Sink: SinkConfig{Type: SinkTypeStdout}, DropPolicy: "drop-all"}},
+		{"invalid field mask", Config{
+			Sink: SinkConfig{Type: SinkTypeStdout},
+			FieldMask: []*fgs.EventFieldMask{{
+				EventSet: []fgs.EventType{fgs.EventType_PROCESS_EXEC},
+				Include:  &fieldmaskpb.FieldMask{Paths: []string{"process.no_such_field"}},
+			}},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.config.request()
+			assert.Error(t, err)
+		})
+	}
+
+	cfg := Config{Sink: SinkConfig{Type: SinkTypeStdout}}
+	req, err := cfg.request()
+	require.NoError(t, err)
+	assert.Equal(t, fgs.ListenerDropPolicy_LISTENER_DROP_POLICY_DROP_OLDEST, req.DropPolicy)
+
+	cfg = Config{
+		Sink:     SinkConfig{Type: SinkTypeFile, Path: "/var/run/tetragon/events.bin"},
+		Encoding: EncodingProtobuf,
+		Protobuf: &protobuf.Config{Compression: protobuf.CompressionZstd},
+	}
+	_, err = cfg.request()
+	require.NoError(t, err)
+
+	cfg = Config{Sink: SinkConfig{
+		Type:  SinkTypeOTLP,
+		OTLP:  &otlp.Config{Endpoint: "localhost:4317"},
+		Spool: &SpoolConfig{Dir: "/tmp/spool"},
+	}}
+	_, err = cfg.request()
+	require.NoError(t, err)
+}
+
+func TestSyslogFraming(t *testing.T) {
+	res := &fgs.GetEventsResponse{Event: &fgs.GetEventsResponse_Test{Test: &fgs.Test{}}}
+	for sinkType, framing := range map[string]string{
+		SinkTypeFile:     "\n",
+		SinkTypeStdout:   "\n",
+		SinkTypeTCP:      "octets",
+		SinkTypeTLS:      "octets",
+		SinkTypeUnix:     "octets",
+		SinkTypeUDP:      "",
+		SinkTypeUnixgram: "",
+	} {
+		var buf bytes.Buffer
+		encoder, err := encoders[EncodingSyslog](&buf, &Config{Sink: SinkConfig{Type: sinkType}})
+		require.NoError(t, err)
+		require.NoError(t, encoder.Encode(res))
+		msg := buf.String()
+		switch framing {
+		case "\n":
+			assert.True(t, strings.HasPrefix(msg, "<") && strings.HasSuffix(msg, "\n"), "%s: %q", sinkType, msg)
+		case "octets":
+			parts := strings.SplitN(msg, " ", 2)
+			require.Len(t, parts, 2)
+			assert.Equal(t, strconv.Itoa(len(parts[1])), parts[0], "%s: %q", sinkType, msg)
+		default:
+			assert.True(t, strings.HasPrefix(msg, "<") && !strings.HasSuffix(msg, "\n"), "%s: %q", sinkType, msg)
+		}
+	}
+}
+
+func TestEncoderClose(t *testing.T) {
+	dir := t.TempDir()
+	res := &fgs.GetEventsResponse{Event: &fgs.GetEventsResponse_Test{Test: &fgs.Test{}}}
+
+	// the buffered events of the encoder are written before the sink is
+	// closed
+	cfg := Config{
+		Name:     "protobuf",
+		Sink:     SinkConfig{Type: SinkTypeFile, Path: filepath.Join(dir, "events.bin")},
+		Encoding: EncodingProtobuf,
+		Protobuf: &protobuf.Config{Compression: protobuf.CompressionZstd},
+	}
+	encoder, err := cfg.newEncoder(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, encoder.Encode(res))
+	require.NoError(t, encoder.Close())
+	f, err := os.Open(cfg.Sink.Path)
+	require.NoError(t, err)
+	defer f.Close()
+	r, err := protobuf.NewReader(f)
+	require.NoError(t, err)
+	defer r.Close()
+	_, err = r.Next()
+	require.NoError(t, err)
+
+	// hash chains end with a checkpoint
+	_, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	keyFile := filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+	cfg = Config{
+		Name: "chain",
+		Sink: SinkConfig{Type: SinkTypeFile, Path: filepath.Join(dir, "events.log"), HashChain: &HashChainConfig{KeyFile: keyFile}},
+	}
+	encoder, err = cfg.newEncoder(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, encoder.Encode(res))
+	require.NoError(t, encoder.Close())
+	data, err := os.ReadFile(cfg.Sink.Path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+	v := chain.NewVerifier(key.Public().(ed25519.PublicKey))
+	for i, kind := range []chain.RecordKind{chain.KindRecord, chain.KindCheckpoint} {
+		got, err := v.Verify([]byte(lines[i]))
+		require.NoError(t, err)
+		assert.Equal(t, kind, got)
+	}
+}
+
+func TestStartExportersValidation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stdout := SinkConfig{Type: SinkTypeStdout}
+	err := StartExporters(ctx, nil, []Config{{Sink: stdout}})
+	assert.Error(t, err, "exporters must have a name")
+	err = StartExporters(ctx, nil, []Config{{Name: "a", Sink: stdout}, {Name: "a", Sink: stdout}})
+	assert.Error(t, err, "exporter names must be unique")
+	err = StartExporters(ctx, nil, []Config{{Name: "a", Sink: stdout}, {Name: "b", Sink: SinkConfig{Type: "kafka"}}})
+	assert.Error(t, err, "invalid configurations must be reported")
+	tcp := SinkConfig{Type: SinkTypeTCP, Address: "localhost:514", Spool: &SpoolConfig{Dir: "/tmp/spool"}}
+	err = StartExporters(ctx, nil, []Config{{Name: "a", Sink: tcp}, {Name: "b", Sink: tcp}})
+	assert.Error(t, err, "spool directories must be unique")
+
+	// no exporter starts when the encoder of another one cannot be
+	// created, the nil server would panic otherwise
+	dir := t.TempDir()
+	file := filepath.Join(dir, "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o600))
+	spooled := SinkConfig{Type: SinkTypeTCP, Address: "localhost:514", Spool: &SpoolConfig{Dir: filepath.Join(dir, "spool")}}
+	broken := SinkConfig{Type: SinkTypeTCP, Address: "localhost:514", Spool: &SpoolConfig{Dir: filepath.Join(file, "spool")}}
+	err = StartExporters(ctx, nil, []Config{{Name: "a", Sink: spooled}, {Name: "b", Sink: broken}})
+	assert.Error(t, err, "encoder failures must be reported")
+}
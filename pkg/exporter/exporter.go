@@ -16,6 +16,7 @@ package exporter
 
 import (
 	"context"
+	"io"
 	"sync"
 
 	"github.com/isovalent/tetragon-oss/api/v1/fgs"
@@ -31,6 +32,7 @@ type ExportEncoder interface {
 
 type Exporter struct {
 	ctx         context.Context
+	name        string
 	request     *fgs.GetEventsRequest
 	server      *server.Server
 	encoder     ExportEncoder
@@ -38,32 +40,48 @@ type Exporter struct {
 	done        chan bool
 }
 
+// NewExporter returns an exporter that encodes the events of request. name
+// identifies its listener in metrics.
 func NewExporter(
 	ctx context.Context,
+	name string,
 	request *fgs.GetEventsRequest,
 	server *server.Server,
 	encoder ExportEncoder,
 	rateLimiter *ratelimit.RateLimiter,
 ) *Exporter {
-	return &Exporter{ctx, request, server, encoder, rateLimiter, make(chan bool)}
+	return &Exporter{ctx, name, request, server, encoder, rateLimiter, make(chan bool)}
 }
 
 func (e *Exporter) Start() {
 	var readyWG sync.WaitGroup
 	readyWG.Add(1)
 	go func() {
-		if err := e.server.GetEventsWG(e.request, e, &readyWG); err != nil {
+		if err := e.server.GetEventsWG(e.request, e, e.name, &readyWG); err != nil {
 			if e.ctx.Err() == nil {
-				logger.GetLogger().WithError(err).Error("Failed to start JSON exporter")
+				logger.GetLogger().WithError(err).WithField("listener", e.name).Error("Failed to start JSON exporter")
 			}
 		}
-		e.done <- true
+		e.close()
+		close(e.done)
 	}()
 	readyWG.Wait()
 }
 
+// close closes the encoder, if it is a closer, once the exporter stopped
+// sending events to it.
+func (e *Exporter) close() {
+	if c, ok := e.encoder.(io.Closer); ok {
+		if err := c.Close(); err != nil {
+			logger.GetLogger().WithError(err).WithField("listener", e.name).Warn("Failed to close exporter")
+		}
+	}
+}
+
 func (e *Exporter) Send(event *fgs.GetEventsResponse) error {
-	if e.rateLimiter != nil && !e.rateLimiter.Allow() {
+	// Notifications are not rate limited, so that the export always says
+	// when it is incomplete.
+	if e.rateLimiter != nil && !server.IsNotification(event) && !e.rateLimiter.Allow() {
 		e.rateLimiter.Drop()
 		return nil
 	}
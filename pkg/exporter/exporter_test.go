@@ -121,7 +121,7 @@ func TestExporter_Send(t *testing.T) {
 	encoder := json.NewEncoder(results)
 	ctx, cancel := context.WithCancel(context.Background())
 	request := fgs.GetEventsRequest{DenyList: []*fgs.Filter{{BinaryRegex: []string{"b"}}}}
-	exporter := NewExporter(ctx, &request, grpcServer, encoder, nil)
+	exporter := NewExporter(ctx, "test", &request, grpcServer, encoder, nil)
 	exporter.Start()
 	eventNotifier.NotifyListener(nil, &fgs.GetEventsResponse{
 		Event: &fgs.GetEventsResponse_ProcessExec{
@@ -141,6 +141,15 @@ func TestExporter_Send(t *testing.T) {
 	<-eventNotifier.removed
 }
 
+func TestExporterInvalidRequest(t *testing.T) {
+	grpcServer := server.NewServer(newFakeNotifier(), &fakeObserver{})
+	request := &fgs.GetEventsRequest{AllowList: []*fgs.Filter{{BinaryRegex: []string{"["}}}}
+	exporter := NewExporter(context.Background(), "test", request, grpcServer, json.NewEncoder(newArrayWriter(1)), nil)
+	// Start returns, and the exporter stops, when the request is rejected
+	exporter.Start()
+	<-exporter.done
+}
+
 type jsonEvent struct {
 	Event         json.RawMessage `json:"process_exec"`
 	RateLimitInfo json.RawMessage `json:"rate_limit_info"`
@@ -168,12 +177,12 @@ func checkEvents(t *testing.T, eventsJSON []string, wantEvents, wantRateLimitInf
 			decoded++
 		}
 		if len(ev.RateLimitInfo) > 0 {
-			var r ratelimit.InfoEvent
+			var r fgs.GetEventsResponse
 			if err := json.Unmarshal([]byte(event), &r); err != nil {
 				t.Fatalf("failed to unmarshal JSON event %q: %v", event, err)
 			}
 			gotRateLimitInfo++
-			gotDropped += r.RateLimitInfo.NumberOfDroppedProcessEvents
+			gotDropped += r.GetRateLimitInfo().GetNumberOfDroppedProcessEvents()
 			decoded++
 
 			if nn := r.NodeName; nn != nodeName {
@@ -224,10 +233,11 @@ func Test_rateLimitExport(t *testing.T) {
 			request := &fgs.GetEventsRequest{}
 			exporter := NewExporter(
 				ctx,
+				"test",
 				request,
 				grpcServer,
 				encoder,
-				ratelimit.NewRateLimiter(ctx, 50*time.Millisecond, tt.rateLimit, encoder),
+				ratelimit.NewRateLimiter(ctx, 50*time.Millisecond, tt.rateLimit, "test", "test", grpcServer),
 			)
 			exporter.Start()
 			for i := 0; i < tt.totalEvents; i++ {
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package otlp
+
+import (
+	"strings"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/isovalent/tetragon-oss/api/v1/fgs/codegen/helpers"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+const scopeName = "tetragon"
+
+// record is a log record, with the attributes of the resource that emitted
+// it.
+type record struct {
+	resource    []*commonpb.KeyValue
+	resourceKey string
+	log         *logspb.LogRecord
+}
+
+func stringAttr(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}},
+	}
+}
+
+func intAttr(key string, value int64) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: value}},
+	}
+}
+
+// attrs builds a list of attributes, skipping empty values.
+type attrs []*commonpb.KeyValue
+
+func (a *attrs) addString(key, value string) {
+	if value != "" {
+		*a = append(*a, stringAttr(key, value))
+	}
+}
+
+func (a *attrs) addInt(key string, value int64, ok bool) {
+	if ok {
+		*a = append(*a, intAttr(key, value))
+	}
+}
+
+// resourceAttributes returns the attributes of the node, pod and container
+// of an event, following the OpenTelemetry semantic conventions.
+func resourceAttributes(res *fgs.GetEventsResponse) attrs {
+	a := attrs{stringAttr("service.name", scopeName)}
+	a.addString("host.name", res.NodeName)
+	a.addString("k8s.node.name", res.NodeName)
+	pod := helpers.ResponseGetProcess(res).GetPod()
+	a.addString("k8s.namespace.name", pod.GetNamespace())
+	a.addString("k8s.pod.name", pod.GetName())
+	container := pod.GetContainer()
+	a.addString("k8s.container.name", container.GetName())
+	a.addString("container.id", container.GetId())
+	a.addString("container.image.name", container.GetImage().GetName())
+	return a
+}
+
+func resourceKey(a attrs) string {
+	var b strings.Builder
+	for _, kv := range a {
+		b.WriteString(kv.Key)
+		b.WriteByte('=')
+		b.WriteString(kv.Value.GetStringValue())
+		b.WriteByte(0)
+	}
+	return b.String()
+}
+
+// addProcess adds the attributes of the process and of the parent of
+// an event.
+func (a *attrs) addProcess(process, parent *fgs.Process) {
+	if process != nil {
+		a.addInt("process.pid", int64(process.Pid.GetValue()), process.Pid != nil)
+		a.addString("process.executable.path", process.Binary)
+		a.addString("process.command_line", strings.TrimSpace(process.Binary+" "+process.Arguments))
+		a.addString("process.working_directory", process.Cwd)
+		a.addInt("tetragon.process.uid", int64(process.Uid.GetValue()), process.Uid != nil)
+		a.addString("tetragon.process.exec_id", process.ExecId)
+		a.addString("tetragon.parent.exec_id", process.ParentExecId)
+	}
+	if parent != nil {
+		a.addInt("process.parent_pid", int64(parent.Pid.GetValue()), parent.Pid != nil)
+		a.addString("tetragon.parent.executable.path", parent.Binary)
+	}
+}
+
+// severity returns the severity of an event. Notifications about lost events
+// are warnings.
+func severity(res *fgs.GetEventsResponse) (logspb.SeverityNumber, string) {
+	switch res.Event.(type) {
+	case *fgs.GetEventsResponse_EventsDropped, *fgs.GetEventsResponse_LostEvents, *fgs.GetEventsResponse_RateLimitInfo:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_WARN, "WARN"
+	}
+	return logspb.SeverityNumber_SEVERITY_NUMBER_INFO, "INFO"
+}
+
+// newRecord converts an event to a log record. The body of the record is the
+// JSON encoding of the event, and its attributes are the fields that are
+// commonly used to search and filter events.
+func newRecord(res *fgs.GetEventsResponse) (*record, error) {
+	body, err := res.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	var a attrs
+	if eventType, err := helpers.EventTypeString(res.Event); err == nil {
+		a.addString("tetragon.event.type", eventType)
+	}
+	a.addProcess(helpers.ResponseGetProcess(res), helpers.ResponseGetParent(res))
+	switch ev := res.Event.(type) {
+	case *fgs.GetEventsResponse_ProcessKprobe:
+		a.addString("tetragon.kprobe.function_name", ev.ProcessKprobe.FunctionName)
+		if ev.ProcessKprobe.Action != fgs.KprobeAction_KPROBE_ACTION_UNKNOWN {
+			a.addString("tetragon.kprobe.action", ev.ProcessKprobe.Action.String())
+		}
+	case *fgs.GetEventsResponse_ProcessTracepoint:
+		a.addString("tetragon.tracepoint.subsys", ev.ProcessTracepoint.Subsys)
+		a.addString("tetragon.tracepoint.event", ev.ProcessTracepoint.Event)
+	}
+	a.addString("tetragon.agent.id", res.AgentId)
+	a.addInt("tetragon.sequence", int64(res.Sequence), res.Sequence != 0)
+
+	sevNumber, sevText := severity(res)
+	log := &logspb.LogRecord{
+		ObservedTimeUnixNano: uint64(time.Now().UnixNano()),
+		SeverityNumber:       sevNumber,
+		SeverityText:         sevText,
+		Body:                 &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: string(body)}},
+		Attributes:           a,
+	}
+	if res.Time != nil {
+		log.TimeUnixNano = uint64(res.Time.AsTime().UnixNano())
+	}
+
+	resource := resourceAttributes(res)
+	return &record{
+		resource:    resource,
+		resourceKey: resourceKey(resource),
+		log:         log,
+	}, nil
+}
+
+// newRequest builds the export request of a batch, grouping the log records
+// by resource.
+func newRequest(batch []*record) *collogspb.ExportLogsServiceRequest {
+	req := &collogspb.ExportLogsServiceRequest{}
+	scopes := map[string]*logspb.ScopeLogs{}
+	for _, rec := range batch {
+		scope, ok := scopes[rec.resourceKey]
+		if !ok {
+			scope = &logspb.ScopeLogs{
+				Scope: &commonpb.InstrumentationScope{Name: scopeName},
+			}
+			scopes[rec.resourceKey] = scope
+			req.ResourceLogs = append(req.ResourceLogs, &logspb.ResourceLogs{
+				Resource:  &resourcepb.Resource{Attributes: rec.resource},
+				ScopeLogs: []*logspb.ScopeLogs{scope},
+			})
+		}
+		scope.LogRecords = append(scope.LogRecords, rec.log)
+	}
+	return req
+}
@@ -0,0 +1,322 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package otlp exports events as OpenTelemetry log records to an OTLP
+// collector.
+package otlp
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/isovalent/tetragon-oss/pkg/logger"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	"google.golang.org/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ProtocolGRPC = "grpc"
+	ProtocolHTTP = "http"
+
+	defaultTimeout              = 10 * time.Second
+	defaultBatchSize            = 512
+	defaultBatchTimeout         = 5 * time.Second
+	defaultQueueSize            = 2048
+	defaultRetryInitialInterval = time.Second
+	defaultRetryMaxInterval     = 30 * time.Second
+	defaultRetryMaxElapsedTime  = 5 * time.Minute
+)
+
+var (
+	errClosed    = errors.New("otlp exporter is closed")
+	errQueueFull = errors.New("otlp exporter queue is full")
+)
+
+// RetryConfig configures the exponential backoff between the attempts to
+// export a batch.
+type RetryConfig struct {
+	// Disabled drops batches after their first failed attempt.
+	Disabled        bool            `json:"disabled,omitempty"`
+	InitialInterval metav1.Duration `json:"initialInterval,omitempty"`
+	MaxInterval     metav1.Duration `json:"maxInterval,omitempty"`
+	// MaxElapsedTime is the time after which a batch that could not be
+	// exported is dropped.
+	MaxElapsedTime metav1.Duration `json:"maxElapsedTime,omitempty"`
+}
+
+// Config configures an OTLP exporter. Zero values select the defaults.
+type Config struct {
+	// Endpoint of the collector. It is a host:port address for grpc, and
+	// a URL for http. The path of http URLs defaults to /v1/logs.
+	Endpoint string `json:"endpoint"`
+	// Protocol is grpc (default) or http.
+	Protocol string `json:"protocol,omitempty"`
+	// Insecure disables TLS.
+	Insecure bool `json:"insecure,omitempty"`
+	// Headers are sent with each export request, for example for
+	// authentication.
+	Headers map[string]string `json:"headers,omitempty"`
+	// Timeout of each export request.
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+	// BatchSize is the maximum number of log records per export request.
+	BatchSize int `json:"batchSize,omitempty"`
+	// BatchTimeout is the maximum time a log record waits for its batch to
+	// be full.
+	BatchTimeout metav1.Duration `json:"batchTimeout,omitempty"`
+	// QueueSize is the number of log records that wait to be batched.
+	// Events are not dropped when the queue is full, they wait in the
+	// listener of the exporter, whose drop policy applies.
+	QueueSize int         `json:"queueSize,omitempty"`
+	Retry     RetryConfig `json:"retry,omitempty"`
+}
+
+// Validate checks the configuration.
+func (c *Config) Validate() error {
+	if c.Endpoint == "" {
+		return errors.New("otlp endpoint is required")
+	}
+	switch c.Protocol {
+	case "", ProtocolGRPC, ProtocolHTTP:
+	default:
+		return fmt.Errorf("unknown otlp protocol %q", c.Protocol)
+	}
+	if c.BatchSize < 0 || c.QueueSize < 0 {
+		return errors.New("otlp batch and queue sizes cannot be negative")
+	}
+	return nil
+}
+
+func durationOr(d metav1.Duration, def time.Duration) time.Duration {
+	if d.Duration <= 0 {
+		return def
+	}
+	return d.Duration
+}
+
+func intOr(i, def int) int {
+	if i <= 0 {
+		return def
+	}
+	return i
+}
+
+// client sends export requests to a collector. Errors are retried, unless
+// they are permanent errors.
+type client interface {
+	export(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error
+	close() error
+}
+
+// permanentError is an export error that retrying does not fix, for example
+// a request that the collector rejects.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Exporter converts events to log records, and exports them in batches. It
+// implements the ExportEncoder interface of the exporter package.
+type Exporter struct {
+	client  client
+	records chan *record
+	cancel  context.CancelFunc
+	done    chan struct{}
+
+	timeout      time.Duration
+	batchSize    int
+	batchTimeout time.Duration
+	retry        RetryConfig
+}
+
+// New creates an OTLP exporter. The exporter flushes its pending records and
+// stops when ctx is done or when it is closed.
+func New(ctx context.Context, cfg *Config) (*Exporter, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	var c client
+	var err error
+	if cfg.Protocol == ProtocolHTTP {
+		c, err = newHTTPClient(cfg)
+	} else {
+		c, err = newGRPCClient(ctx, cfg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	e := &Exporter{
+		client:       c,
+		records:      make(chan *record, intOr(cfg.QueueSize, defaultQueueSize)),
+		cancel:       cancel,
+		done:         make(chan struct{}),
+		timeout:      durationOr(cfg.Timeout, defaultTimeout),
+		batchSize:    intOr(cfg.BatchSize, defaultBatchSize),
+		batchTimeout: durationOr(cfg.BatchTimeout, defaultBatchTimeout),
+		retry: RetryConfig{
+			Disabled:        cfg.Retry.Disabled,
+			InitialInterval: metav1.Duration{Duration: durationOr(cfg.Retry.InitialInterval, defaultRetryInitialInterval)},
+			MaxInterval:     metav1.Duration{Duration: durationOr(cfg.Retry.MaxInterval, defaultRetryMaxInterval)},
+			MaxElapsedTime:  metav1.Duration{Duration: durationOr(cfg.Retry.MaxElapsedTime, defaultRetryMaxElapsedTime)},
+		},
+	}
+	go e.run(ctx)
+	return e, nil
+}
+
+// Encode queues an event to be exported. It blocks while the queue is full.
+func (e *Exporter) Encode(v interface{}) error {
+	res, ok := v.(*fgs.GetEventsResponse)
+	if !ok {
+		return fmt.Errorf("otlp exporter cannot encode %T", v)
+	}
+	rec, err := newRecord(res)
+	if err != nil {
+		return err
+	}
+	// the queue may have room after the exporter stopped
+	select {
+	case <-e.done:
+		return errClosed
+	default:
+	}
+	select {
+	case e.records <- rec:
+		return nil
+	case <-e.done:
+		return errClosed
+	}
+}
+
+// Write queues an event encoded as a single length-delimited GetEventsResponse
+// message, as written by the protobuf encoding of the exporters. It lets the
+// exporter be the destination of a spool. Unlike Encode, it does not block
+// while the queue is full but fails, so that the spool keeps the event until
+// the queue has room.
+func (e *Exporter) Write(p []byte) (int, error) {
+	select {
+	case <-e.done:
+		return 0, errClosed
+	default:
+	}
+	// Events that cannot be decoded are dropped, since writing them again
+	// would fail the same way.
+	length, n := binary.Uvarint(p)
+	if n <= 0 || length != uint64(len(p)-n) {
+		logger.GetLogger().WithField("size", len(p)).Warn("Dropping invalid event written to OTLP exporter")
+		return len(p), nil
+	}
+	var res fgs.GetEventsResponse
+	if err := proto.Unmarshal(p[n:], &res); err != nil {
+		logger.GetLogger().WithError(err).Warn("Dropping invalid event written to OTLP exporter")
+		return len(p), nil
+	}
+	rec, err := newRecord(&res)
+	if err != nil {
+		logger.GetLogger().WithError(err).Warn("Dropping event that cannot be converted to an OTLP log record")
+		return len(p), nil
+	}
+	select {
+	case e.records <- rec:
+		return len(p), nil
+	default:
+		return 0, errQueueFull
+	}
+}
+
+// Close stops the exporter, and returns once it made a last attempt to export
+// the queued records and closed its connection to the collector.
+func (e *Exporter) Close() error {
+	e.cancel()
+	<-e.done
+	return nil
+}
+
+func (e *Exporter) run(ctx context.Context) {
+	defer close(e.done)
+	defer e.client.close()
+
+	timer := time.NewTimer(e.batchTimeout)
+	defer timer.Stop()
+	var batch []*record
+	for {
+		select {
+		case rec := <-e.records:
+			batch = append(batch, rec)
+			if len(batch) < e.batchSize {
+				continue
+			}
+		case <-timer.C:
+		case <-ctx.Done():
+			// last attempt, without retries, to export what is left
+			for len(e.records) > 0 {
+				batch = append(batch, <-e.records)
+			}
+			if len(batch) > 0 {
+				flushCtx, cancel := context.WithTimeout(context.Background(), e.timeout)
+				e.exportOnce(flushCtx, batch)
+				cancel()
+			}
+			return
+		}
+		if len(batch) > 0 {
+			e.export(ctx, batch)
+			batch = nil
+		}
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(e.batchTimeout)
+	}
+}
+
+func (e *Exporter) exportOnce(ctx context.Context, batch []*record) error {
+	ctx, cancel := context.WithTimeout(ctx, e.timeout)
+	defer cancel()
+	err := e.client.export(ctx, newRequest(batch))
+	if err != nil {
+		logger.GetLogger().WithError(err).WithField("records", len(batch)).Warn("Failed to export events to OTLP collector")
+	}
+	return err
+}
+
+// export exports a batch, retrying with an exponential backoff.
+func (e *Exporter) export(ctx context.Context, batch []*record) {
+	interval := e.retry.InitialInterval.Duration
+	deadline := time.Now().Add(e.retry.MaxElapsedTime.Duration)
+	for {
+		err := e.exportOnce(ctx, batch)
+		if err == nil {
+			return
+		}
+		var perr *permanentError
+		if e.retry.Disabled || errors.As(err, &perr) || time.Now().Add(interval).After(deadline) {
+			logger.GetLogger().WithField("records", len(batch)).Warn("Dropping events that could not be exported to OTLP collector")
+			return
+		}
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return
+		}
+		interval *= 2
+		if interval > e.retry.MaxInterval.Duration {
+			interval = e.retry.MaxInterval.Duration
+		}
+	}
+}
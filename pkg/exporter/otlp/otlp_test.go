@@ -0,0 +1,335 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package otlp
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// receiver is an in-process OTLP collector. It fails the first requests
+// with the given errors.
+type receiver struct {
+	collogspb.UnimplementedLogsServiceServer
+
+	mu       sync.Mutex
+	errors   []error
+	attempts int
+	requests []*collogspb.ExportLogsServiceRequest
+	headers  []metadata.MD
+}
+
+func (r *receiver) Export(ctx context.Context, req *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.attempts++
+	md, _ := metadata.FromIncomingContext(ctx)
+	r.headers = append(r.headers, md)
+	if len(r.errors) > 0 {
+		err := r.errors[0]
+		r.errors = r.errors[1:]
+		return nil, err
+	}
+	r.requests = append(r.requests, req)
+	return &collogspb.ExportLogsServiceResponse{}, nil
+}
+
+func (r *receiver) records() []*logspb.LogRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var ret []*logspb.LogRecord
+	for _, req := range r.requests {
+		for _, rl := range req.ResourceLogs {
+			for _, sl := range rl.ScopeLogs {
+				ret = append(ret, sl.LogRecords...)
+			}
+		}
+	}
+	return ret
+}
+
+func (r *receiver) numAttempts() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.attempts
+}
+
+func startGRPCReceiver(t *testing.T, r *receiver) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := grpc.NewServer()
+	collogspb.RegisterLogsServiceServer(s, r)
+	go s.Serve(l)
+	t.Cleanup(s.Stop)
+	return l.Addr().String()
+}
+
+func testConfig(endpoint string) *Config {
+	return &Config{
+		Endpoint:     endpoint,
+		Insecure:     true,
+		BatchSize:    2,
+		BatchTimeout: metav1.Duration{Duration: 50 * time.Millisecond},
+		Retry: RetryConfig{
+			InitialInterval: metav1.Duration{Duration: 10 * time.Millisecond},
+			MaxInterval:     metav1.Duration{Duration: 10 * time.Millisecond},
+			MaxElapsedTime:  metav1.Duration{Duration: 5 * time.Second},
+		},
+	}
+}
+
+func execEvent(pid uint32, pod string) *fgs.GetEventsResponse {
+	return &fgs.GetEventsResponse{
+		Event: &fgs.GetEventsResponse_ProcessExec{ProcessExec: &fgs.ProcessExec{
+			Process: &fgs.Process{
+				ExecId:    "exec-id",
+				Pid:       wrapperspb.UInt32(pid),
+				Binary:    "/usr/bin/curl",
+				Arguments: "cilium.io",
+				Pod: &fgs.Pod{
+					Namespace: "default",
+					Name:      pod,
+					Container: &fgs.Container{Id: "containerd://abc", Name: "curl"},
+				},
+			},
+			Parent: &fgs.Process{Pid: wrapperspb.UInt32(1)},
+		}},
+		NodeName: "node-1",
+		Time:     timestamppb.New(time.Unix(1, 2)),
+		AgentId:  "agent",
+		Sequence: uint64(pid),
+	}
+}
+
+func attributes(kvs []*commonpb.KeyValue) map[string]interface{} {
+	ret := map[string]interface{}{}
+	for _, kv := range kvs {
+		switch v := kv.Value.Value.(type) {
+		case *commonpb.AnyValue_StringValue:
+			ret[kv.Key] = v.StringValue
+		case *commonpb.AnyValue_IntValue:
+			ret[kv.Key] = v.IntValue
+		}
+	}
+	return ret
+}
+
+func TestNewRecord(t *testing.T) {
+	rec, err := newRecord(execEvent(42, "curl-pod"))
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"service.name":       "tetragon",
+		"host.name":          "node-1",
+		"k8s.node.name":      "node-1",
+		"k8s.namespace.name": "default",
+		"k8s.pod.name":       "curl-pod",
+		"k8s.container.name": "curl",
+		"container.id":       "containerd://abc",
+	}, attributes(rec.resource))
+
+	assert.Equal(t, map[string]interface{}{
+		"tetragon.event.type":      "PROCESS_EXEC",
+		"process.pid":              int64(42),
+		"process.executable.path":  "/usr/bin/curl",
+		"process.command_line":     "/usr/bin/curl cilium.io",
+		"tetragon.process.exec_id": "exec-id",
+		"process.parent_pid":       int64(1),
+		"tetragon.agent.id":        "agent",
+		"tetragon.sequence":        int64(42),
+	}, attributes(rec.log.Attributes))
+	assert.Equal(t, uint64(time.Unix(1, 2).UnixNano()), rec.log.TimeUnixNano)
+	assert.Equal(t, logspb.SeverityNumber_SEVERITY_NUMBER_INFO, rec.log.SeverityNumber)
+	assert.Contains(t, rec.log.Body.GetStringValue(), `"binary":"/usr/bin/curl"`)
+
+	rec, err = newRecord(&fgs.GetEventsResponse{
+		Event: &fgs.GetEventsResponse_LostEvents{LostEvents: &fgs.LostEvents{Count: 3}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, logspb.SeverityNumber_SEVERITY_NUMBER_WARN, rec.log.SeverityNumber)
+}
+
+func TestNewRequest(t *testing.T) {
+	var batch []*record
+	for i, pod := range []string{"a", "b", "a"} {
+		rec, err := newRecord(execEvent(uint32(i), pod))
+		require.NoError(t, err)
+		batch = append(batch, rec)
+	}
+	req := newRequest(batch)
+	require.Len(t, req.ResourceLogs, 2)
+	assert.Len(t, req.ResourceLogs[0].ScopeLogs[0].LogRecords, 2)
+	assert.Equal(t, "a", attributes(req.ResourceLogs[0].Resource.Attributes)["k8s.pod.name"])
+	assert.Len(t, req.ResourceLogs[1].ScopeLogs[0].LogRecords, 1)
+	assert.Equal(t, "b", attributes(req.ResourceLogs[1].Resource.Attributes)["k8s.pod.name"])
+}
+
+func TestExporterGRPC(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r := &receiver{errors: []error{status.Error(codes.Unavailable, "not yet")}}
+	cfg := testConfig(startGRPCReceiver(t, r))
+	cfg.Headers = map[string]string{"authorization": "secret"}
+	e, err := New(ctx, cfg)
+	require.NoError(t, err)
+
+	for i := uint32(1); i <= 3; i++ {
+		require.NoError(t, e.Encode(execEvent(i, "pod")))
+	}
+	// the first batch is retried, and the last one is sent by the batch
+	// timeout
+	assert.Eventually(t, func() bool { return len(r.records()) == 3 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 3, r.numAttempts())
+	assert.Equal(t, []string{"secret"}, r.headers[0].Get("authorization"))
+}
+
+func TestExporterGRPCPermanentError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r := &receiver{errors: []error{status.Error(codes.InvalidArgument, "bad request")}}
+	cfg := testConfig(startGRPCReceiver(t, r))
+	cfg.BatchSize = 1
+	e, err := New(ctx, cfg)
+	require.NoError(t, err)
+
+	require.NoError(t, e.Encode(execEvent(1, "pod")))
+	require.NoError(t, e.Encode(execEvent(2, "pod")))
+	assert.Eventually(t, func() bool { return len(r.records()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	// the first event is dropped without retries
+	assert.Equal(t, 2, r.numAttempts())
+	assert.Equal(t, int64(2), attributes(r.records()[0].Attributes)["process.pid"])
+}
+
+func TestExporterFlushOnClose(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	r := &receiver{}
+	cfg := testConfig(startGRPCReceiver(t, r))
+	cfg.BatchSize = 100
+	cfg.BatchTimeout = metav1.Duration{Duration: time.Hour}
+	e, err := New(ctx, cfg)
+	require.NoError(t, err)
+
+	require.NoError(t, e.Encode(execEvent(1, "pod")))
+	cancel()
+	<-e.done
+	assert.Len(t, r.records(), 1)
+	assert.Equal(t, errClosed, e.Encode(execEvent(2, "pod")))
+}
+
+func TestExporterClose(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r := &receiver{}
+	cfg := testConfig(startGRPCReceiver(t, r))
+	cfg.BatchSize = 100
+	cfg.BatchTimeout = metav1.Duration{Duration: time.Hour}
+	e, err := New(ctx, cfg)
+	require.NoError(t, err)
+
+	require.NoError(t, e.Encode(execEvent(1, "pod")))
+	// Close returns once the queued records are exported
+	require.NoError(t, e.Close())
+	assert.Len(t, r.records(), 1)
+	assert.Equal(t, errClosed, e.Encode(execEvent(2, "pod")))
+}
+
+func TestExporterWrite(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r := &receiver{errors: []error{status.Error(codes.Unavailable, "not yet")}}
+	cfg := testConfig(startGRPCReceiver(t, r))
+	cfg.BatchSize = 1
+	cfg.QueueSize = 1
+	cfg.Retry.InitialInterval = metav1.Duration{Duration: time.Second}
+	e, err := New(ctx, cfg)
+	require.NoError(t, err)
+
+	write := func(pid uint32) error {
+		data, err := proto.Marshal(execEvent(pid, "pod"))
+		require.NoError(t, err)
+		_, err = e.Write(protowire.AppendBytes(nil, data))
+		return err
+	}
+	require.NoError(t, write(1))
+	// the first export is retried after a second, while the queue fills
+	assert.Eventually(t, func() bool { return r.numAttempts() == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, write(2))
+	assert.Equal(t, errQueueFull, write(3))
+
+	// invalid events are dropped
+	n, err := e.Write([]byte{5, 1})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	assert.Eventually(t, func() bool { return len(r.records()) == 2 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, e.Close())
+	assert.Equal(t, errClosed, write(4))
+}
+
+func TestExporterHTTP(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r := &receiver{errors: []error{status.Error(codes.Unavailable, "not yet")}}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/v1/logs" || req.Header.Get("Content-Type") != "application/x-protobuf" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body, err := ioutil.ReadAll(req.Body)
+		require.NoError(t, err)
+		var exportReq collogspb.ExportLogsServiceRequest
+		require.NoError(t, proto.Unmarshal(body, &exportReq))
+		if _, err := r.Export(context.Background(), &exportReq); err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-protobuf")
+	}))
+	defer s.Close()
+
+	cfg := testConfig(s.URL)
+	cfg.Protocol = ProtocolHTTP
+	e, err := New(ctx, cfg)
+	require.NoError(t, err)
+
+	require.NoError(t, e.Encode(execEvent(1, "pod")))
+	require.NoError(t, e.Encode(execEvent(2, "pod")))
+	assert.Eventually(t, func() bool { return len(r.records()) == 2 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 2, r.numAttempts())
+}
+
+func TestConfigValidate(t *testing.T) {
+	assert.Error(t, (&Config{}).Validate())
+	assert.Error(t, (&Config{Endpoint: "localhost:4317", Protocol: "udp"}).Validate())
+	assert.NoError(t, (&Config{Endpoint: "localhost:4317"}).Validate())
+	assert.NoError(t, (&Config{Endpoint: "localhost:4318", Protocol: ProtocolHTTP}).Validate())
+}
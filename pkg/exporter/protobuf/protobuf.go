@@ -0,0 +1,266 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package protobuf implements a binary export format: GetEventsResponse
+// messages, each prefixed with its length as a varint, as written by
+// writeDelimitedTo in the Java protobuf library.
+//
+// The messages can be compressed with zstd, in segments of consecutive
+// messages. Each segment is a zstd frame, written with a single Write, so that
+// rotated files always start and end on a frame boundary and can be read on
+// their own.
+package protobuf
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	CompressionNone = "none"
+	CompressionZstd = "zstd"
+
+	defaultSegmentEvents = 1000
+	defaultFlushInterval = time.Second
+	// maxSegmentSize bounds the uncompressed size of a segment.
+	maxSegmentSize = 4 * 1024 * 1024
+	// maxMessageSize bounds the size of the messages that are read, so that
+	// corrupted lengths do not cause huge allocations.
+	maxMessageSize = 64 * 1024 * 1024
+)
+
+var (
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	gzipMagic = []byte{0x1f, 0x8b}
+)
+
+// Config configures the protobuf encoding.
+type Config struct {
+	// Compression is none (default) or zstd.
+	Compression string `json:"compression,omitempty"`
+	// SegmentEvents is the maximum number of events of a compressed
+	// segment. Defaults to 1000.
+	SegmentEvents int `json:"segmentEvents,omitempty"`
+	// FlushInterval is the maximum time that events are kept in a
+	// compressed segment before it is written. Defaults to 1s.
+	FlushInterval metav1.Duration `json:"flushInterval,omitempty"`
+}
+
+// Validate checks the configuration.
+func (c *Config) Validate() error {
+	switch c.Compression {
+	case "", CompressionNone, CompressionZstd:
+	default:
+		return fmt.Errorf("unknown compression %q", c.Compression)
+	}
+	if c.SegmentEvents < 0 {
+		return fmt.Errorf("segment events must not be negative")
+	}
+	if c.FlushInterval.Duration < 0 {
+		return fmt.Errorf("flush interval must not be negative")
+	}
+	return nil
+}
+
+// Encoder writes events as length-delimited messages.
+type Encoder struct {
+	w             io.Writer
+	zstd          *zstd.Encoder
+	segmentEvents int
+	flushInterval time.Duration
+
+	mu     sync.Mutex
+	buf    []byte
+	events int
+	timer  *time.Timer
+	// err is the error of the last flush by the timer, returned by the
+	// next call to Encode.
+	err error
+}
+
+// NewEncoder creates an encoder that writes to w.
+func NewEncoder(w io.Writer, cfg *Config) (*Encoder, error) {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	e := &Encoder{
+		w:             w,
+		segmentEvents: cfg.SegmentEvents,
+		flushInterval: cfg.FlushInterval.Duration,
+	}
+	if e.segmentEvents == 0 {
+		e.segmentEvents = defaultSegmentEvents
+	}
+	if e.flushInterval == 0 {
+		e.flushInterval = defaultFlushInterval
+	}
+	if cfg.Compression == CompressionZstd {
+		var err error
+		e.zstd, err = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+// Encode writes an event. Without compression, each event is written with a
+// single Write. With compression, the event is added to the current segment,
+// which is written once it is full or after the flush interval.
+func (e *Encoder) Encode(v interface{}) error {
+	res, ok := v.(*fgs.GetEventsResponse)
+	if !ok {
+		return fmt.Errorf("protobuf encoder cannot encode %T", v)
+	}
+	data, err := proto.Marshal(res)
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if err := e.err; err != nil {
+		e.err = nil
+		return err
+	}
+	e.buf = appendDelimited(e.buf, data)
+	if e.zstd == nil {
+		_, err := e.w.Write(e.buf)
+		e.buf = e.buf[:0]
+		return err
+	}
+	e.events++
+	if e.events >= e.segmentEvents || len(e.buf) >= maxSegmentSize {
+		return e.flushLocked()
+	}
+	if e.timer == nil {
+		e.timer = time.AfterFunc(e.flushInterval, func() {
+			e.mu.Lock()
+			defer e.mu.Unlock()
+			e.timer = nil
+			e.err = e.flushLocked()
+		})
+	}
+	return nil
+}
+
+func appendDelimited(b []byte, data []byte) []byte {
+	var length [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(length[:], uint64(len(data)))
+	b = append(b, length[:n]...)
+	return append(b, data...)
+}
+
+// Flush writes the current segment.
+func (e *Encoder) Flush() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.flushLocked()
+}
+
+func (e *Encoder) flushLocked() error {
+	if e.timer != nil {
+		e.timer.Stop()
+		e.timer = nil
+	}
+	if e.events == 0 {
+		return nil
+	}
+	frame := e.zstd.EncodeAll(e.buf, nil)
+	e.buf = e.buf[:0]
+	e.events = 0
+	_, err := e.w.Write(frame)
+	return err
+}
+
+// Reader reads the events written by an Encoder. Compressed input, and
+// gzip-compressed input such as rotated files, is detected and decompressed.
+type Reader struct {
+	r     *bufio.Reader
+	close func()
+}
+
+// NewReader creates a reader of r.
+func NewReader(r io.Reader) (*Reader, error) {
+	ret := &Reader{r: bufio.NewReader(r), close: func() {}}
+	// gzip-compressed files can themselves hold zstd frames
+	for {
+		magic, err := ret.r.Peek(len(zstdMagic))
+		if err != nil && err != io.EOF {
+			ret.Close()
+			return nil, err
+		}
+		closeInner := ret.close
+		switch {
+		case bytes.HasPrefix(magic, zstdMagic):
+			dec, err := zstd.NewReader(ret.r, zstd.WithDecoderConcurrency(1))
+			if err != nil {
+				ret.Close()
+				return nil, err
+			}
+			ret.r = bufio.NewReader(dec)
+			ret.close = func() {
+				dec.Close()
+				closeInner()
+			}
+		case bytes.HasPrefix(magic, gzipMagic):
+			dec, err := gzip.NewReader(ret.r)
+			if err != nil {
+				ret.Close()
+				return nil, err
+			}
+			ret.r = bufio.NewReader(dec)
+			ret.close = func() {
+				dec.Close()
+				closeInner()
+			}
+		default:
+			return ret, nil
+		}
+	}
+}
+
+// Next reads the next event. It returns io.EOF at the end of the input, and
+// io.ErrUnexpectedEOF if the input ends with a partial message.
+func (r *Reader) Next() (*fgs.GetEventsResponse, error) {
+	length, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return nil, err
+	}
+	if length > maxMessageSize {
+		return nil, fmt.Errorf("message of %d bytes exceeds the maximum of %d bytes", length, maxMessageSize)
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	var res fgs.GetEventsResponse
+	if err := proto.Unmarshal(data, &res); err != nil {
+		return nil, fmt.Errorf("failed to parse event: %w", err)
+	}
+	return &res, nil
+}
+
+// Close releases the resources of the reader. It does not close the
+// underlying reader.
+func (r *Reader) Close() {
+	r.close()
+}
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package protobuf
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// writes records the Write calls.
+type writes struct {
+	mu     sync.Mutex
+	writes [][]byte
+}
+
+func (w *writes) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.writes = append(w.writes, append([]byte(nil), p...))
+	return len(p), nil
+}
+
+func (w *writes) count() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.writes)
+}
+
+func (w *writes) bytes() []byte {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return bytes.Join(w.writes, nil)
+}
+
+func execEvent(pid uint32) *fgs.GetEventsResponse {
+	return &fgs.GetEventsResponse{
+		Event: &fgs.GetEventsResponse_ProcessExec{ProcessExec: &fgs.ProcessExec{
+			Process: &fgs.Process{Pid: wrapperspb.UInt32(pid), Binary: "/usr/bin/curl"},
+		}},
+		NodeName: "node-1",
+	}
+}
+
+func readAll(t *testing.T, data []byte) []*fgs.GetEventsResponse {
+	r, err := NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	defer r.Close()
+	var ret []*fgs.GetEventsResponse
+	for {
+		res, err := r.Next()
+		if err == io.EOF {
+			return ret
+		}
+		require.NoError(t, err)
+		ret = append(ret, res)
+	}
+}
+
+func assertEvents(t *testing.T, pids []uint32, events []*fgs.GetEventsResponse) {
+	require.Len(t, events, len(pids))
+	for i, pid := range pids {
+		assert.True(t, proto.Equal(execEvent(pid), events[i]), "event %d: %v", i, events[i])
+	}
+}
+
+func TestEncoder(t *testing.T) {
+	w := &writes{}
+	e, err := NewEncoder(w, nil)
+	require.NoError(t, err)
+	for pid := uint32(1); pid <= 3; pid++ {
+		require.NoError(t, e.Encode(execEvent(pid)))
+	}
+	// one write per event
+	assert.Equal(t, 3, w.count())
+	assertEvents(t, []uint32{1, 2, 3}, readAll(t, w.bytes()))
+	assert.Error(t, e.Encode("event"))
+}
+
+func TestEncoderZstd(t *testing.T) {
+	w := &writes{}
+	e, err := NewEncoder(w, &Config{
+		Compression:   CompressionZstd,
+		SegmentEvents: 2,
+		FlushInterval: metav1.Duration{Duration: time.Hour},
+	})
+	require.NoError(t, err)
+	for pid := uint32(1); pid <= 5; pid++ {
+		require.NoError(t, e.Encode(execEvent(pid)))
+	}
+	// one frame per full segment
+	assert.Equal(t, 2, w.count())
+	require.NoError(t, e.Flush())
+	assert.Equal(t, 3, w.count())
+	require.NoError(t, e.Flush())
+	assert.Equal(t, 3, w.count())
+
+	assertEvents(t, []uint32{1, 2, 3, 4, 5}, readAll(t, w.bytes()))
+	// each frame can be read on its own, as after a rotation
+	assertEvents(t, []uint32{3, 4}, readAll(t, w.writes[1]))
+}
+
+func TestEncoderFlushInterval(t *testing.T) {
+	w := &writes{}
+	e, err := NewEncoder(w, &Config{
+		Compression:   CompressionZstd,
+		FlushInterval: metav1.Duration{Duration: 10 * time.Millisecond},
+	})
+	require.NoError(t, err)
+	require.NoError(t, e.Encode(execEvent(1)))
+	assert.Eventually(t, func() bool { return w.count() == 1 }, time.Second, 5*time.Millisecond)
+	assertEvents(t, []uint32{1}, readAll(t, w.bytes()))
+}
+
+func TestReaderGzip(t *testing.T) {
+	w := &writes{}
+	e, err := NewEncoder(w, &Config{Compression: CompressionZstd})
+	require.NoError(t, err)
+	require.NoError(t, e.Encode(execEvent(1)))
+	require.NoError(t, e.Flush())
+
+	// rotated files compressed with gzip
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err = gz.Write(w.bytes())
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	assertEvents(t, []uint32{1}, readAll(t, buf.Bytes()))
+}
+
+func TestReaderTruncated(t *testing.T) {
+	w := &writes{}
+	e, err := NewEncoder(w, nil)
+	require.NoError(t, err)
+	require.NoError(t, e.Encode(execEvent(1)))
+	require.NoError(t, e.Encode(execEvent(2)))
+	data := w.bytes()
+
+	r, err := NewReader(bytes.NewReader(data[:len(data)-1]))
+	require.NoError(t, err)
+	_, err = r.Next()
+	require.NoError(t, err)
+	_, err = r.Next()
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+
+	assert.Empty(t, readAll(t, nil))
+}
+
+func TestConfigValidate(t *testing.T) {
+	assert.NoError(t, (&Config{}).Validate())
+	assert.NoError(t, (&Config{Compression: CompressionZstd}).Validate())
+	assert.Error(t, (&Config{Compression: "lz4"}).Validate())
+	assert.Error(t, (&Config{SegmentEvents: -1}).Validate())
+	assert.Error(t, (&Config{FlushInterval: metav1.Duration{Duration: -time.Second}}).Validate())
+}
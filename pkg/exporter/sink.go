@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package exporter
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cilium/lumberjack/v2"
+	"github.com/isovalent/tetragon-oss/pkg/logger"
+)
+
+const (
+	SinkTypeFile     = "file"
+	SinkTypeStdout   = "stdout"
+	SinkTypeUnix     = "unix"
+	SinkTypeUnixgram = "unixgram"
+	SinkTypeTCP      = "tcp"
+	SinkTypeTLS      = "tls"
+	SinkTypeUDP      = "udp"
+	SinkTypeOTLP     = "otlp"
+
+	// sinkDialTimeout bounds the time spent connecting to socket sinks, so
+	// that an unreachable sink does not stall its exporter for long.
+	sinkDialTimeout = 5 * time.Second
+	// sinkRetryInterval is the minimum time between two connection
+	// attempts to a socket sink.
+	sinkRetryInterval = 5 * time.Second
+)
+
+// Sink is the destination of the events of an exporter. Each Write call
+// receives one or more whole encoded events. Encoders that write to datagram
+// sinks (unixgram and udp) must write one event per Write call.
+type Sink interface {
+	io.WriteCloser
+}
+
+// openSink creates the sink of an exporter, which the caller must close.
+func openSink(ctx context.Context, cfg *SinkConfig) (Sink, error) {
+	var sink Sink
+	switch cfg.Type {
+	case SinkTypeFile:
+		sink = newFileSink(ctx, cfg)
+	case SinkTypeStdout:
+		sink = stdoutSink{}
+	case SinkTypeUnix, SinkTypeUnixgram:
+		sink = newSocketSink(cfg.Type, cfg.Path)
+	case SinkTypeTCP, SinkTypeUDP:
+		sink = newSocketSink(cfg.Type, cfg.Address)
+	case SinkTypeTLS:
+		tlsConfig, err := cfg.TLS.tlsConfig()
+		if err != nil {
+			return nil, err
+		}
+		s := newSocketSink("tcp", cfg.Address)
+		s.dialer = &tls.Dialer{
+			NetDialer: &net.Dialer{Timeout: sinkDialTimeout},
+			Config:    tlsConfig,
+		}
+		sink = s
+	default:
+		return nil, fmt.Errorf("unknown sink type %q", cfg.Type)
+	}
+	return sink, nil
+}
+
+func newFileSink(ctx context.Context, cfg *SinkConfig) Sink {
+	writer := &lumberjack.Logger{
+		Filename:   cfg.Path,
+		MaxSize:    cfg.MaxSizeMB,
+		MaxBackups: cfg.MaxBackups,
+		Compress:   cfg.Compress,
+	}
+	if interval := cfg.RotationInterval.Duration; interval != 0 {
+		logger.GetLogger().WithField("duration", interval).WithField("filename", cfg.Path).Info("Periodically rotating export file")
+		go func() {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if err := writer.Rotate(); err != nil {
+						logger.GetLogger().WithError(err).
+							WithField("filename", cfg.Path).
+							Warn("Failed to rotate export file")
+					}
+				}
+			}
+		}()
+	}
+	return writer
+}
+
+type stdoutSink struct{}
+
+func (stdoutSink) Write(p []byte) (int, error) {
+	return os.Stdout.Write(p)
+}
+
+func (stdoutSink) Close() error {
+	return nil
+}
+
+// isStreamSink returns whether a sink type is a stream, rather than a datagram
+// socket.
+func isStreamSink(sinkType string) bool {
+	return sinkType != SinkTypeUDP && sinkType != SinkTypeUnixgram
+}
+
+type dialer interface {
+	Dial(network, address string) (net.Conn, error)
+}
+
+// socketSink writes events to a socket. It connects on the first write, and
+// reconnects on the next write after an error, so that the exporter keeps
+// running while the receiving end is unavailable. Events written while it is
+// unavailable are lost.
+type socketSink struct {
+	network string
+	address string
+	dialer  dialer
+
+	mu      sync.Mutex
+	conn    net.Conn
+	closed  bool
+	retryAt time.Time
+}
+
+func newSocketSink(network, address string) *socketSink {
+	return &socketSink{
+		network: network,
+		address: address,
+		dialer:  &net.Dialer{Timeout: sinkDialTimeout},
+	}
+}
+
+func (s *socketSink) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return 0, net.ErrClosed
+	}
+	if s.conn == nil {
+		if time.Now().Before(s.retryAt) {
+			return 0, fmt.Errorf("%s sink %s is not connected", s.network, s.address)
+		}
+		conn, err := s.dialer.Dial(s.network, s.address)
+		if err != nil {
+			s.retryAt = time.Now().Add(sinkRetryInterval)
+			return 0, fmt.Errorf("failed to connect to %s sink %s: %w", s.network, s.address, err)
+		}
+		s.conn = conn
+	}
+	n, err := s.conn.Write(p)
+	if err != nil {
+		s.conn.Close()
+		s.conn = nil
+	}
+	return n, err
+}
+
+func (s *socketSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+// SinkTLSConfig configures the TLS connections of tls sinks.
+type SinkTLSConfig struct {
+	// CAFile is the PEM file of the certificate authorities that verify
+	// the server. Defaults to the system ones.
+	CAFile string `json:"caFile,omitempty"`
+	// CertFile and KeyFile are the PEM files of the client certificate,
+	// for servers that authenticate clients.
+	CertFile string `json:"certFile,omitempty"`
+	KeyFile  string `json:"keyFile,omitempty"`
+	// ServerName verifies the server, when it differs from the host of
+	// the address.
+	ServerName         string `json:"serverName,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+}
+
+func (c *SinkTLSConfig) tlsConfig() (*tls.Config, error) {
+	cfg := &tls.Config{}
+	if c == nil {
+		return cfg, nil
+	}
+	cfg.ServerName = c.ServerName
+	cfg.InsecureSkipVerify = c.InsecureSkipVerify
+	if c.CAFile != "" {
+		pem, err := ioutil.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		cfg.RootCAs = x509.NewCertPool()
+		if !cfg.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate in CA file %s", c.CAFile)
+		}
+	}
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
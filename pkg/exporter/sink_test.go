@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package exporter
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSocketSink(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	path := filepath.Join(t.TempDir(), "sink.sock")
+	sink, err := openSink(ctx, &SinkConfig{Type: SinkTypeUnix, Path: path})
+	require.NoError(t, err)
+
+	// writes fail, without blocking, while nothing listens
+	_, err = sink.Write([]byte("lost\n"))
+	assert.Error(t, err)
+
+	l, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	defer l.Close()
+
+	// the sink does not reconnect before the retry interval
+	_, err = sink.Write([]byte("lost\n"))
+	assert.Error(t, err)
+	sink.(*socketSink).retryAt = time.Time{}
+
+	_, err = sink.Write([]byte("event 1\n"))
+	require.NoError(t, err)
+	conn, err := l.Accept()
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = sink.Write([]byte("event 2\n"))
+	require.NoError(t, err)
+
+	r := bufio.NewReader(conn)
+	for _, expected := range []string{"event 1\n", "event 2\n"} {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		assert.Equal(t, expected, line)
+	}
+
+	require.NoError(t, sink.Close())
+	_, err = sink.Write([]byte("closed\n"))
+	assert.Equal(t, net.ErrClosed, err)
+}
+
+func TestUDPSink(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	sink, err := openSink(ctx, &SinkConfig{Type: SinkTypeUDP, Address: conn.LocalAddr().String()})
+	require.NoError(t, err)
+	defer sink.Close()
+	for _, msg := range []string{"event 1", "event 2"} {
+		_, err = sink.Write([]byte(msg))
+		require.NoError(t, err)
+	}
+
+	// each write is a datagram
+	buf := make([]byte, 1024)
+	for _, expected := range []string{"event 1", "event 2"} {
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+		n, _, err := conn.ReadFrom(buf)
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(buf[:n]))
+	}
+}
+
+// selfSignedCert returns a certificate for 127.0.0.1, and writes it to a PEM
+// file.
+func selfSignedCert(t *testing.T) (tls.Certificate, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	fname := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(fname, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, fname
+}
+
+func TestTLSSink(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cert, caFile := selfSignedCert(t)
+	l, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{cert}})
+	require.NoError(t, err)
+	defer l.Close()
+
+	_, err = openSink(ctx, &SinkConfig{Type: SinkTypeTLS, Address: l.Addr().String(), TLS: &SinkTLSConfig{CAFile: "/nonexistent"}})
+	assert.Error(t, err)
+
+	sink, err := openSink(ctx, &SinkConfig{Type: SinkTypeTLS, Address: l.Addr().String(), TLS: &SinkTLSConfig{CAFile: caFile}})
+	require.NoError(t, err)
+	defer sink.Close()
+	go func() {
+		sink.Write([]byte("event 1\n"))
+	}()
+	conn, err := l.Accept()
+	require.NoError(t, err)
+	defer conn.Close()
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "event 1\n", line)
+}
@@ -0,0 +1,574 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package spool implements a bounded on-disk queue, that keeps the events of
+// an exporter while its sink is unavailable.
+//
+// The queue is a directory of segment files, named after the offset of their
+// first record. Each record is a header, with the length, CRC32-C checksum and
+// time of the record, followed by its data. The offset of the first record
+// that was not acknowledged is kept in the ack file, so that delivery resumes
+// from there after a restart.
+package spool
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	segmentExt  = ".seg"
+	ackFileName = "ack"
+
+	// record header: length (4 bytes), checksum (4 bytes) and time in
+	// nanoseconds (8 bytes)
+	headerSize = 16
+
+	DefaultMaxSize     = 100 * 1024 * 1024
+	DefaultSegmentSize = 8 * 1024 * 1024
+
+	// ackPersistInterval bounds how often the ack offset is written, so
+	// that up to this interval of events is delivered again after a
+	// restart.
+	ackPersistInterval = time.Second
+)
+
+var (
+	ErrClosed = errors.New("spool is closed")
+
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// CorruptionError is returned when records are dropped because they could not
+// be read.
+type CorruptionError struct {
+	Segment string
+	Dropped uint64
+	Err     error
+}
+
+func (e *CorruptionError) Error() string {
+	return fmt.Sprintf("dropped %d records of segment %s: %v", e.Dropped, e.Segment, e.Err)
+}
+
+func (e *CorruptionError) Unwrap() error {
+	return e.Err
+}
+
+// Options configures a spool. Zero values select the defaults.
+type Options struct {
+	// MaxSize is the maximum size of the spool, in bytes. When it is
+	// reached, the oldest segment is dropped.
+	MaxSize int64
+	// SegmentSize is the size at which segments are rotated.
+	SegmentSize int64
+}
+
+// Record is a spooled record.
+type Record struct {
+	Offset uint64
+	Time   time.Time
+	Data   []byte
+}
+
+type segment struct {
+	first uint64
+	path  string
+	size  int64
+}
+
+// Spool is a persistent FIFO queue of records, with a single consumer that
+// acknowledges the records in order.
+type Spool struct {
+	dir  string
+	opts Options
+
+	mu       sync.Mutex
+	closed   bool
+	segments []*segment
+	size     int64
+	// next is the offset of the next appended record, and acked the
+	// offset of the first record that was not acknowledged.
+	next      uint64
+	acked     uint64
+	ackedSave time.Time
+	writer    *os.File
+	// reader reads the segment of the first record that was not
+	// acknowledged, head is that record once read.
+	reader    *os.File
+	readerSeg *segment
+	head      *Record
+	available chan struct{}
+}
+
+func segmentPath(dir string, first uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%020d%s", first, segmentExt))
+}
+
+// Open opens the spool of a directory, creating it if needed.
+func Open(dir string, opts Options) (*Spool, error) {
+	if opts.MaxSize <= 0 {
+		opts.MaxSize = DefaultMaxSize
+	}
+	if opts.SegmentSize <= 0 {
+		opts.SegmentSize = DefaultSegmentSize
+	}
+	if opts.SegmentSize > opts.MaxSize {
+		opts.SegmentSize = opts.MaxSize
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	s := &Spool{
+		dir:       dir,
+		opts:      opts,
+		available: make(chan struct{}, 1),
+	}
+	if err := s.load(); err != nil {
+		s.closeFiles()
+		return nil, fmt.Errorf("failed to open spool %s: %w", dir, err)
+	}
+	return s, nil
+}
+
+func (s *Spool) load() error {
+	entries, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+		first, err := strconv.ParseUint(strings.TrimSuffix(name, segmentExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		s.segments = append(s.segments, &segment{
+			first: first,
+			path:  filepath.Join(s.dir, name),
+			size:  e.Size(),
+		})
+		s.size += e.Size()
+	}
+	sort.Slice(s.segments, func(i, j int) bool {
+		return s.segments[i].first < s.segments[j].first
+	})
+
+	if len(s.segments) > 0 {
+		// the last segment can end with a record that was partially
+		// written when the agent stopped
+		last := s.segments[len(s.segments)-1]
+		count, size, err := scanSegment(last.path)
+		if err != nil {
+			return err
+		}
+		if size != last.size {
+			if err := os.Truncate(last.path, size); err != nil {
+				return err
+			}
+			s.size -= last.size - size
+			last.size = size
+		}
+		s.next = last.first + count
+	}
+
+	s.acked = s.readAck()
+	if len(s.segments) > 0 && s.acked < s.segments[0].first {
+		s.acked = s.segments[0].first
+	}
+	if s.acked > s.next {
+		s.acked = s.next
+	}
+	if err := s.removeAckedSegments(); err != nil {
+		return err
+	}
+
+	if len(s.segments) == 0 {
+		return s.rotate()
+	}
+	last := s.segments[len(s.segments)-1]
+	s.writer, err = os.OpenFile(last.path, os.O_WRONLY|os.O_APPEND, 0o600)
+	return err
+}
+
+// scanSegment returns the number of valid records of a segment, and their
+// size.
+func scanSegment(path string) (uint64, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return 0, 0, err
+	}
+	var count uint64
+	var size int64
+	for {
+		n, err := skipRecord(f, info.Size()-size, true)
+		if err != nil {
+			return count, size, nil
+		}
+		count++
+		size += n
+	}
+}
+
+// readRecord reads the next record of a segment, of which remaining bytes are
+// left. A length that exceeds them is corrupted, and is rejected before the
+// data is allocated.
+func readRecord(r io.Reader, remaining int64) (time.Time, []byte, error) {
+	var hdr [headerSize]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return time.Time{}, nil, err
+	}
+	length := binary.BigEndian.Uint32(hdr[0:4])
+	if int64(length) > remaining-headerSize {
+		return time.Time{}, nil, fmt.Errorf("record of %d bytes exceeds the %d remaining bytes of the segment", length, remaining-headerSize)
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return time.Time{}, nil, err
+	}
+	crc := crc32.Update(crc32.Checksum(hdr[8:16], crcTable), crcTable, data)
+	if crc != binary.BigEndian.Uint32(hdr[4:8]) {
+		return time.Time{}, nil, errors.New("invalid record checksum")
+	}
+	return time.Unix(0, int64(binary.BigEndian.Uint64(hdr[8:16]))), data, nil
+}
+
+// skipRecord skips the next record of a segment, of which remaining bytes are
+// left, verifying its checksum if verify is set, and returns its size.
+func skipRecord(f *os.File, remaining int64, verify bool) (int64, error) {
+	if verify {
+		_, data, err := readRecord(f, remaining)
+		return int64(headerSize + len(data)), err
+	}
+	var hdr [headerSize]byte
+	if _, err := io.ReadFull(f, hdr[:]); err != nil {
+		return 0, err
+	}
+	length := int64(binary.BigEndian.Uint32(hdr[0:4]))
+	if length > remaining-headerSize {
+		return 0, fmt.Errorf("record of %d bytes exceeds the %d remaining bytes of the segment", length, remaining-headerSize)
+	}
+	if _, err := f.Seek(length, io.SeekCurrent); err != nil {
+		return 0, err
+	}
+	return headerSize + length, nil
+}
+
+// remaining returns the number of bytes of a segment after the offset of f.
+func remaining(f *os.File, seg *segment) (int64, error) {
+	pos, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+	return seg.size - pos, nil
+}
+
+func (s *Spool) readAck() uint64 {
+	data, err := ioutil.ReadFile(filepath.Join(s.dir, ackFileName))
+	if err != nil {
+		return 0
+	}
+	acked, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return acked
+}
+
+// saveAck persists the ack offset, atomically.
+func (s *Spool) saveAck() error {
+	tmp := filepath.Join(s.dir, ackFileName+".tmp")
+	if err := ioutil.WriteFile(tmp, []byte(strconv.FormatUint(s.acked, 10)+"\n"), 0o600); err != nil {
+		return err
+	}
+	s.ackedSave = time.Now()
+	return os.Rename(tmp, filepath.Join(s.dir, ackFileName))
+}
+
+// removeAckedSegments removes the segments whose records were all
+// acknowledged, except the last one, which is being written.
+func (s *Spool) removeAckedSegments() error {
+	for len(s.segments) > 1 && s.segments[1].first <= s.acked {
+		if err := s.removeFirstSegment(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Spool) removeFirstSegment() error {
+	seg := s.segments[0]
+	if s.readerSeg == seg {
+		s.reader.Close()
+		s.reader = nil
+		s.readerSeg = nil
+	}
+	s.segments = s.segments[1:]
+	s.size -= seg.size
+	return os.Remove(seg.path)
+}
+
+// rotate starts a new segment.
+func (s *Spool) rotate() error {
+	if s.writer != nil {
+		s.writer.Close()
+		s.writer = nil
+	}
+	seg := &segment{first: s.next, path: segmentPath(s.dir, s.next)}
+	f, err := os.OpenFile(seg.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	s.writer = f
+	s.segments = append(s.segments, seg)
+	return nil
+}
+
+// Append adds a record to the spool. It returns the number of records that
+// were dropped to keep the spool within its maximum size.
+func (s *Spool) Append(data []byte) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return 0, ErrClosed
+	}
+	recSize := int64(headerSize + len(data))
+	last := s.segments[len(s.segments)-1]
+	if last.size > 0 && last.size+recSize > s.opts.SegmentSize {
+		if err := s.rotate(); err != nil {
+			return 0, err
+		}
+		last = s.segments[len(s.segments)-1]
+	}
+
+	buf := make([]byte, recSize)
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(data)))
+	binary.BigEndian.PutUint64(buf[8:16], uint64(time.Now().UnixNano()))
+	copy(buf[headerSize:], data)
+	binary.BigEndian.PutUint32(buf[4:8], crc32.Checksum(buf[8:], crcTable))
+	// a single write, so that readers never see partial records
+	if _, err := s.writer.Write(buf); err != nil {
+		return 0, err
+	}
+	last.size += recSize
+	s.size += recSize
+	s.next++
+
+	var dropped uint64
+	for s.size > s.opts.MaxSize && len(s.segments) > 1 {
+		next := s.segments[1].first
+		if s.acked < next {
+			dropped += next - s.acked
+			s.acked = next
+			s.head = nil
+		}
+		if err := s.removeFirstSegment(); err != nil {
+			return dropped, err
+		}
+	}
+	if dropped > 0 {
+		if err := s.saveAck(); err != nil {
+			return dropped, err
+		}
+	}
+
+	select {
+	case s.available <- struct{}{}:
+	default:
+	}
+	return dropped, nil
+}
+
+// readHead reads the first record that was not acknowledged. It must be
+// called with records available. Corrupted records are dropped, with the rest
+// of their segment.
+func (s *Spool) readHead() error {
+	if s.head != nil {
+		return nil
+	}
+	// find the segment of the record
+	var seg *segment
+	for i := len(s.segments) - 1; i >= 0; i-- {
+		if s.segments[i].first <= s.acked {
+			seg = s.segments[i]
+			break
+		}
+	}
+	if seg == nil {
+		return fmt.Errorf("no segment contains offset %d", s.acked)
+	}
+	if s.readerSeg != seg {
+		if s.reader != nil {
+			s.reader.Close()
+			s.reader = nil
+			s.readerSeg = nil
+		}
+		f, err := os.Open(seg.path)
+		if err != nil {
+			return err
+		}
+		s.reader = f
+		s.readerSeg = seg
+		var pos int64
+		for i := seg.first; i < s.acked; i++ {
+			n, err := skipRecord(f, seg.size-pos, false)
+			if err != nil {
+				return s.dropSegment(seg, err)
+			}
+			pos += n
+		}
+	}
+	left, err := remaining(s.reader, seg)
+	if err != nil {
+		return err
+	}
+	t, data, err := readRecord(s.reader, left)
+	if err != nil {
+		return s.dropSegment(seg, err)
+	}
+	s.head = &Record{Offset: s.acked, Time: t, Data: data}
+	return nil
+}
+
+// dropSegment drops the records of a segment that were not acknowledged,
+// after a read error.
+func (s *Spool) dropSegment(seg *segment, readErr error) error {
+	end := s.next
+	for i, other := range s.segments {
+		if other == seg && i+1 < len(s.segments) {
+			end = s.segments[i+1].first
+		}
+	}
+	dropped := end - s.acked
+	s.acked = end
+	s.reader.Close()
+	s.reader = nil
+	s.readerSeg = nil
+	if err := s.removeAckedSegments(); err != nil {
+		return err
+	}
+	if err := s.saveAck(); err != nil {
+		return err
+	}
+	return &CorruptionError{Segment: seg.path, Dropped: dropped, Err: readErr}
+}
+
+// Peek returns the first record that was not acknowledged, waiting for one
+// if the spool is empty.
+func (s *Spool) Peek(ctx context.Context) (*Record, error) {
+	for {
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			return nil, ErrClosed
+		}
+		if s.acked < s.next {
+			err := s.readHead()
+			head := s.head
+			s.mu.Unlock()
+			return head, err
+		}
+		s.mu.Unlock()
+
+		select {
+		case <-s.available:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Ack acknowledges the record returned by Peek.
+func (s *Spool) Ack(rec *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return ErrClosed
+	}
+	if rec.Offset != s.acked {
+		// the record was dropped in the meantime
+		return nil
+	}
+	s.acked++
+	s.head = nil
+	if err := s.removeAckedSegments(); err != nil {
+		return err
+	}
+	if s.acked == s.next || time.Since(s.ackedSave) >= ackPersistInterval {
+		return s.saveAck()
+	}
+	return nil
+}
+
+// Stats describes the records of the spool that were not acknowledged.
+type Stats struct {
+	Records uint64
+	// Size is the size of the spool on disk.
+	Size int64
+	// Oldest is the time of the oldest record, zero if there is none or if
+	// the consumer did not read it yet.
+	Oldest time.Time
+}
+
+// Stats returns the statistics of the spool.
+func (s *Spool) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := Stats{Records: s.next - s.acked, Size: s.size}
+	if s.head != nil {
+		stats.Oldest = s.head.Time
+	}
+	return stats
+}
+
+func (s *Spool) closeFiles() {
+	if s.writer != nil {
+		s.writer.Close()
+		s.writer = nil
+	}
+	if s.reader != nil {
+		s.reader.Close()
+		s.reader = nil
+		s.readerSeg = nil
+	}
+}
+
+// Close persists the ack offset and closes the spool.
+func (s *Spool) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	err := s.saveAck()
+	s.closeFiles()
+	select {
+	case s.available <- struct{}{}:
+	default:
+	}
+	return err
+}
@@ -0,0 +1,214 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package spool
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func appendRecords(t *testing.T, s *Spool, from, to int) {
+	for i := from; i < to; i++ {
+		_, err := s.Append([]byte(fmt.Sprintf("event-%d", i)))
+		require.NoError(t, err)
+	}
+}
+
+func consume(t *testing.T, s *Spool, n int) []string {
+	var ret []string
+	for i := 0; i < n; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		rec, err := s.Peek(ctx)
+		cancel()
+		require.NoError(t, err)
+		ret = append(ret, string(rec.Data))
+		require.NoError(t, s.Ack(rec))
+	}
+	return ret
+}
+
+func events(from, to int) []string {
+	var ret []string
+	for i := from; i < to; i++ {
+		ret = append(ret, fmt.Sprintf("event-%d", i))
+	}
+	return ret
+}
+
+func TestSpoolOrder(t *testing.T) {
+	s, err := Open(t.TempDir(), Options{SegmentSize: 64})
+	require.NoError(t, err)
+	defer s.Close()
+
+	appendRecords(t, s, 0, 10)
+	stats := s.Stats()
+	assert.Equal(t, uint64(10), stats.Records)
+	assert.Greater(t, stats.Size, int64(0))
+
+	assert.Equal(t, events(0, 5), consume(t, s, 5))
+	_, err = s.Peek(context.Background())
+	require.NoError(t, err)
+	assert.False(t, s.Stats().Oldest.IsZero())
+	appendRecords(t, s, 10, 12)
+	assert.Equal(t, events(5, 12), consume(t, s, 7))
+	assert.Equal(t, uint64(0), s.Stats().Records)
+
+	// acknowledging a record twice has no effect
+	appendRecords(t, s, 12, 14)
+	rec, err := s.Peek(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, s.Ack(rec))
+	require.NoError(t, s.Ack(rec))
+	assert.Equal(t, events(13, 14), consume(t, s, 1))
+}
+
+func TestSpoolPeekWaits(t *testing.T) {
+	s, err := Open(t.TempDir(), Options{})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = s.Peek(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		s.Append([]byte("late"))
+	}()
+	rec, err := s.Peek(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "late", string(rec.Data))
+
+	require.NoError(t, s.Close())
+	_, err = s.Peek(context.Background())
+	assert.Equal(t, ErrClosed, err)
+	_, err = s.Append([]byte("closed"))
+	assert.Equal(t, ErrClosed, err)
+}
+
+func TestSpoolReopen(t *testing.T) {
+	dir := t.TempDir()
+	s, err := Open(dir, Options{SegmentSize: 64})
+	require.NoError(t, err)
+	appendRecords(t, s, 0, 10)
+	assert.Equal(t, events(0, 4), consume(t, s, 4))
+	require.NoError(t, s.Close())
+
+	// delivery resumes from the first record that was not acknowledged
+	s, err = Open(dir, Options{SegmentSize: 64})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), s.Stats().Records)
+	appendRecords(t, s, 10, 11)
+	assert.Equal(t, events(4, 11), consume(t, s, 7))
+	require.NoError(t, s.Close())
+
+	// acknowledged segments are removed, except the last one
+	matches, err := filepath.Glob(filepath.Join(dir, "*"+segmentExt))
+	require.NoError(t, err)
+	assert.Len(t, matches, 1)
+}
+
+func TestSpoolTruncatedTail(t *testing.T) {
+	dir := t.TempDir()
+	s, err := Open(dir, Options{})
+	require.NoError(t, err)
+	appendRecords(t, s, 0, 3)
+	require.NoError(t, s.Close())
+
+	// a partially written record, as after a crash
+	path := segmentPath(dir, 0)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, info.Size()-3))
+
+	s, err = Open(dir, Options{})
+	require.NoError(t, err)
+	defer s.Close()
+	assert.Equal(t, uint64(2), s.Stats().Records)
+	appendRecords(t, s, 3, 4)
+	assert.Equal(t, []string{"event-0", "event-1", "event-3"}, consume(t, s, 3))
+}
+
+func TestSpoolCorruption(t *testing.T) {
+	dir := t.TempDir()
+	s, err := Open(dir, Options{SegmentSize: 64})
+	require.NoError(t, err)
+	defer s.Close()
+	appendRecords(t, s, 0, 10)
+
+	// corrupt the data of the first record
+	f, err := os.OpenFile(segmentPath(dir, 0), os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.WriteAt([]byte("X"), headerSize)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	_, err = s.Peek(context.Background())
+	var corruption *CorruptionError
+	require.ErrorAs(t, err, &corruption)
+	// the first segment holds 2 records of 23 bytes
+	assert.Equal(t, uint64(2), corruption.Dropped)
+	assert.Equal(t, events(2, 10), consume(t, s, 8))
+}
+
+func TestSpoolCorruptedLength(t *testing.T) {
+	dir := t.TempDir()
+	s, err := Open(dir, Options{SegmentSize: 64})
+	require.NoError(t, err)
+	defer s.Close()
+	appendRecords(t, s, 0, 10)
+
+	// the length of the first record exceeds the size of its segment
+	f, err := os.OpenFile(segmentPath(dir, 0), os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.WriteAt([]byte{0xff, 0xff, 0xff, 0xff}, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	_, err = s.Peek(context.Background())
+	var corruption *CorruptionError
+	require.ErrorAs(t, err, &corruption)
+	assert.Equal(t, uint64(2), corruption.Dropped)
+	assert.Equal(t, events(2, 10), consume(t, s, 8))
+
+	// the lengths of partially written records are checked when the last
+	// segment is scanned
+	path := segmentPath(dir, 8)
+	require.NoError(t, s.Close())
+	f, err = os.OpenFile(path, os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.WriteAt([]byte{0x7f, 0xff, 0xff, 0xff}, 23)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	s, err = Open(dir, Options{SegmentSize: 64})
+	require.NoError(t, err)
+	defer s.Close()
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, int64(23), info.Size())
+}
+
+func TestSpoolMaxSize(t *testing.T) {
+	s, err := Open(t.TempDir(), Options{MaxSize: 128, SegmentSize: 64})
+	require.NoError(t, err)
+	defer s.Close()
+
+	var dropped uint64
+	for i := 0; i < 10; i++ {
+		n, err := s.Append([]byte(fmt.Sprintf("event-%d", i)))
+		require.NoError(t, err)
+		dropped += n
+	}
+	stats := s.Stats()
+	assert.LessOrEqual(t, stats.Size, int64(128))
+	assert.Equal(t, uint64(10), dropped+stats.Records)
+	assert.Equal(t, events(int(dropped), 10), consume(t, s, int(stats.Records)))
+}
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package spool
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/pkg/logger"
+	"github.com/isovalent/tetragon-oss/pkg/metrics"
+	"github.com/sirupsen/logrus"
+)
+
+const metricsInterval = 10 * time.Second
+
+// Writer writes to a spool, and forwards the spooled writes to a destination
+// in the background. Writes to the destination that fail are retried, in
+// order, until they succeed, so that the events are kept while the
+// destination is unavailable.
+//
+// Each write is a record, so that datagram destinations still receive one
+// event per write.
+type Writer struct {
+	name          string
+	spool         *Spool
+	dst           io.WriteCloser
+	retryInterval time.Duration
+	log           logrus.FieldLogger
+
+	cancel    context.CancelFunc
+	done      chan struct{}
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// NewWriter opens the spool of a directory and starts forwarding its records
+// to dst, retrying failed writes after retryInterval. name labels the
+// metrics of the spool. Forwarding stops when ctx is done, and the records
+// written after that are kept in the spool until the writer, which the
+// caller must close, is closed with dst.
+func NewWriter(ctx context.Context, name, dir string, opts Options, dst io.WriteCloser, retryInterval time.Duration) (*Writer, error) {
+	s, err := Open(dir, opts)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	w := &Writer{
+		name:          name,
+		spool:         s,
+		dst:           dst,
+		retryInterval: retryInterval,
+		log:           logger.GetLogger().WithField("exporter", name),
+		cancel:        cancel,
+		done:          make(chan struct{}),
+	}
+	if stats := s.Stats(); stats.Records > 0 {
+		w.log.WithField("events", stats.Records).Info("Replaying spooled events")
+	}
+	w.updateMetrics()
+	go w.forward(ctx)
+	return w, nil
+}
+
+// Write appends p to the spool.
+func (w *Writer) Write(p []byte) (int, error) {
+	dropped, err := w.spool.Append(p)
+	if dropped > 0 {
+		w.log.WithField("events", dropped).Warn("Spool is full, dropped the oldest events")
+		metrics.ExporterSpoolDropped.WithLabelValues(w.name).Add(float64(dropped))
+	}
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// forward writes the spooled records to the destination.
+func (w *Writer) forward(ctx context.Context) {
+	defer close(w.done)
+
+	ticker := time.NewTicker(metricsInterval)
+	defer ticker.Stop()
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				w.updateMetrics()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	failing := false
+	for {
+		rec, err := w.spool.Peek(ctx)
+		if err != nil {
+			if ctx.Err() != nil || err == ErrClosed {
+				return
+			}
+			w.log.WithError(err).Warn("Failed to read spooled event")
+			var corruption *CorruptionError
+			if errors.As(err, &corruption) {
+				metrics.ExporterSpoolDropped.WithLabelValues(w.name).Add(float64(corruption.Dropped))
+				continue
+			}
+			select {
+			case <-time.After(w.retryInterval):
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+		if _, err := w.dst.Write(rec.Data); err != nil {
+			if !failing {
+				w.log.WithError(err).Warn("Failed to write event, spooling events until the sink recovers")
+				failing = true
+			}
+			select {
+			case <-time.After(w.retryInterval):
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+		if failing {
+			w.log.Info("Sink recovered, forwarding spooled events")
+			failing = false
+		}
+		if err := w.spool.Ack(rec); err != nil {
+			if err == ErrClosed {
+				return
+			}
+			w.log.WithError(err).Warn("Failed to acknowledge spooled event")
+		}
+	}
+}
+
+func (w *Writer) updateMetrics() {
+	stats := w.spool.Stats()
+	metrics.ExporterSpoolEvents.WithLabelValues(w.name).Set(float64(stats.Records))
+	metrics.ExporterSpoolBytes.WithLabelValues(w.name).Set(float64(stats.Size))
+	age := 0.0
+	if !stats.Oldest.IsZero() {
+		age = time.Since(stats.Oldest).Seconds()
+	}
+	metrics.ExporterSpoolOldestEventAge.WithLabelValues(w.name).Set(age)
+}
+
+// Close stops forwarding, and closes the spool and the destination. The
+// records that were not forwarded are kept in the spool.
+func (w *Writer) Close() error {
+	w.closeOnce.Do(func() {
+		w.cancel()
+		<-w.done
+		w.closeErr = w.spool.Close()
+		if err := w.dst.Close(); err != nil && w.closeErr == nil {
+			w.closeErr = err
+		}
+	})
+	return w.closeErr
+}
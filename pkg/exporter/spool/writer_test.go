@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package spool
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyWriter fails while down is set.
+type flakyWriter struct {
+	mu     sync.Mutex
+	down   bool
+	writes []string
+	closed bool
+}
+
+func (w *flakyWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.down {
+		return 0, errors.New("connection refused")
+	}
+	w.writes = append(w.writes, string(p))
+	return len(p), nil
+}
+
+func (w *flakyWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.closed = true
+	return nil
+}
+
+func (w *flakyWriter) setDown(down bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.down = down
+}
+
+func (w *flakyWriter) received() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]string(nil), w.writes...)
+}
+
+func TestWriter(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dir := t.TempDir()
+	dst := &flakyWriter{down: true}
+	w, err := NewWriter(ctx, "test", dir, Options{}, dst, 10*time.Millisecond)
+	require.NoError(t, err)
+
+	for _, ev := range events(0, 3) {
+		n, err := w.Write([]byte(ev))
+		require.NoError(t, err)
+		assert.Equal(t, len(ev), n)
+	}
+	time.Sleep(30 * time.Millisecond)
+	assert.Empty(t, dst.received())
+	assert.Equal(t, uint64(3), w.spool.Stats().Records)
+
+	// the events are forwarded in order once the destination recovers
+	dst.setDown(false)
+	assert.Eventually(t, func() bool { return len(dst.received()) == 3 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, events(0, 3), dst.received())
+
+	// events that were not forwarded before close are kept
+	dst.setDown(true)
+	_, err = w.Write([]byte("event-3"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	assert.True(t, dst.closed)
+
+	dst = &flakyWriter{}
+	w, err = NewWriter(ctx, "test", dir, Options{}, dst, 10*time.Millisecond)
+	require.NoError(t, err)
+	defer w.Close()
+	assert.Eventually(t, func() bool { return len(dst.received()) == 1 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, events(3, 4), dst.received())
+}
+
+func TestWriterStopOnContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	dir := t.TempDir()
+	dst := &flakyWriter{}
+	w, err := NewWriter(ctx, "test", dir, Options{}, dst, 10*time.Millisecond)
+	require.NoError(t, err)
+
+	// the events written after ctx is done, while the exporter flushes
+	// its encoder, are spooled until the writer is closed
+	cancel()
+	<-w.done
+	_, err = w.Write([]byte("event-0"))
+	require.NoError(t, err)
+	assert.False(t, dst.closed)
+	require.NoError(t, w.Close())
+	assert.True(t, dst.closed)
+	assert.Empty(t, dst.received())
+	_, err = w.Write([]byte("event-1"))
+	assert.Equal(t, ErrClosed, err)
+
+	dst = &flakyWriter{}
+	w, err = NewWriter(context.Background(), "test", dir, Options{}, dst, 10*time.Millisecond)
+	require.NoError(t, err)
+	defer w.Close()
+	assert.Eventually(t, func() bool { return len(dst.received()) == 1 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, events(0, 1), dst.received())
+}
@@ -0,0 +1,319 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package syslog
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/isovalent/tetragon-oss/api/v1/fgs/codegen/helpers"
+	"github.com/isovalent/tetragon-oss/pkg/version"
+)
+
+const (
+	// rfc5424Time is the RFC 3339 profile of RFC 5424, which allows at most
+	// microseconds.
+	rfc5424Time = "2006-01-02T15:04:05.000000Z07:00"
+	nilValue    = "-"
+
+	cefVendor  = "Isovalent"
+	cefProduct = "Tetragon"
+)
+
+// event holds the fields of an event that the messages use.
+type event struct {
+	res       *fgs.GetEventsResponse
+	eventType fgs.EventType
+	process   *fgs.Process
+	policy    string
+	action    fgs.KprobeAction
+	time      time.Time
+}
+
+func newEvent(res *fgs.GetEventsResponse) *event {
+	ev := &event{
+		res:     res,
+		process: helpers.ResponseGetProcess(res),
+		time:    time.Now(),
+	}
+	if name, err := helpers.EventTypeString(res.Event); err == nil {
+		ev.eventType = fgs.EventType(fgs.EventType_value[name])
+	}
+	if res.Time != nil {
+		ev.time = res.Time.AsTime()
+	}
+	switch e := res.Event.(type) {
+	case *fgs.GetEventsResponse_ProcessKprobe:
+		ev.policy = e.ProcessKprobe.PolicyName
+		ev.action = e.ProcessKprobe.Action
+	case *fgs.GetEventsResponse_ProcessTracepoint:
+		ev.policy = e.ProcessTracepoint.PolicyName
+	}
+	return ev
+}
+
+// summary is a short human readable description of the event.
+func (ev *event) summary() string {
+	var parts []string
+	parts = append(parts, strings.ToLower(ev.eventType.String()))
+	if ev.process != nil {
+		parts = append(parts, ev.process.Binary)
+	}
+	switch e := ev.res.Event.(type) {
+	case *fgs.GetEventsResponse_ProcessExec:
+		parts = append(parts, ev.process.GetArguments())
+	case *fgs.GetEventsResponse_ProcessExit:
+		if e.ProcessExit.Signal != "" {
+			parts = append(parts, e.ProcessExit.Signal)
+		} else {
+			parts = append(parts, strconv.FormatUint(uint64(e.ProcessExit.Status), 10))
+		}
+	case *fgs.GetEventsResponse_ProcessKprobe:
+		parts = append(parts, e.ProcessKprobe.FunctionName)
+	case *fgs.GetEventsResponse_ProcessTracepoint:
+		parts = append(parts, e.ProcessTracepoint.Subsys+"/"+e.ProcessTracepoint.Event)
+	case *fgs.GetEventsResponse_EventsDropped:
+		parts = append(parts, strconv.FormatUint(e.EventsDropped.Count, 10))
+	case *fgs.GetEventsResponse_LostEvents:
+		parts = append(parts, strconv.FormatUint(e.LostEvents.Count, 10))
+	case *fgs.GetEventsResponse_RateLimitInfo:
+		parts = append(parts, strconv.FormatUint(e.RateLimitInfo.NumberOfDroppedProcessEvents, 10))
+	}
+	return strings.TrimSpace(strings.Join(parts, " "))
+}
+
+// appendHeaderValue appends a header field, which is printable ASCII without
+// spaces, of at most maxLen characters.
+func appendHeaderValue(b []byte, value string, maxLen int) []byte {
+	if value == "" {
+		return append(b, nilValue...)
+	}
+	if len(value) > maxLen {
+		value = value[:maxLen]
+	}
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c <= ' ' || c > '~' {
+			c = '_'
+		}
+		b = append(b, c)
+	}
+	return b
+}
+
+// textChar replaces the control characters of the message text with spaces,
+// so that the arguments of a process cannot break the framing of the
+// messages, for instance with a newline.
+func textChar(c byte) byte {
+	if c < ' ' || c == 0x7f {
+		return ' '
+	}
+	return c
+}
+
+// appendText appends the free form text of a message.
+func appendText(b []byte, text string) []byte {
+	for i := 0; i < len(text); i++ {
+		b = append(b, textChar(text[i]))
+	}
+	return b
+}
+
+// appendHeader appends the RFC 5424 header, up to the structured data.
+func (e *Encoder) appendHeader(b []byte, ev *event, sev Severity) []byte {
+	b = append(b, '<')
+	b = strconv.AppendInt(b, int64(e.facility*8+int(sev)), 10)
+	b = append(b, ">1 "...)
+	b = append(b, ev.time.UTC().Format(rfc5424Time)...)
+	b = append(b, ' ')
+	hostname := e.hostname
+	if hostname == "" {
+		hostname = ev.res.NodeName
+	}
+	b = appendHeaderValue(b, hostname, 255)
+	b = append(b, ' ')
+	b = appendHeaderValue(b, e.appName, 48)
+	b = append(b, ' ')
+	b = append(b, nilValue...) // PROCID
+	b = append(b, ' ')
+	return appendHeaderValue(b, ev.eventType.String(), 32)
+}
+
+// sdElement builds an RFC 5424 structured data element, skipping empty
+// parameters.
+type sdElement struct {
+	b      []byte
+	params int
+}
+
+func (sd *sdElement) add(name, value string) {
+	if value == "" {
+		return
+	}
+	sd.b = append(sd.b, ' ')
+	sd.b = append(sd.b, name...)
+	sd.b = append(sd.b, `="`...)
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; c {
+		case '"', '\\', ']':
+			sd.b = append(sd.b, '\\', c)
+		default:
+			sd.b = append(sd.b, textChar(c))
+		}
+	}
+	sd.b = append(sd.b, '"')
+	sd.params++
+}
+
+func (sd *sdElement) addUint(name string, value uint64, ok bool) {
+	if ok {
+		sd.add(name, strconv.FormatUint(value, 10))
+	}
+}
+
+// appendSD appends an element if it has parameters.
+func appendSD(b []byte, id string, sd *sdElement) []byte {
+	if sd.params == 0 {
+		return b
+	}
+	b = append(b, '[')
+	b = append(b, id...)
+	b = append(b, sd.b...)
+	return append(b, ']')
+}
+
+// appendRFC5424 appends an RFC 5424 message, with the process, pod and policy
+// fields of the event as structured data.
+func (e *Encoder) appendRFC5424(b []byte, ev *event, sev Severity) []byte {
+	b = e.appendHeader(b, ev, sev)
+	b = append(b, ' ')
+	start := len(b)
+
+	var meta sdElement
+	meta.add("node", ev.res.NodeName)
+	meta.add("agent_id", ev.res.AgentId)
+	meta.addUint("sequence", ev.res.Sequence, ev.res.Sequence != 0)
+	b = appendSD(b, "event"+e.sdSuffix, &meta)
+
+	if p := ev.process; p != nil {
+		var process sdElement
+		process.add("exec_id", p.ExecId)
+		process.addUint("pid", uint64(p.Pid.GetValue()), p.Pid != nil)
+		process.addUint("uid", uint64(p.Uid.GetValue()), p.Uid != nil)
+		process.add("binary", p.Binary)
+		process.add("arguments", p.Arguments)
+		process.add("cwd", p.Cwd)
+		process.add("parent_exec_id", p.ParentExecId)
+		b = appendSD(b, "process"+e.sdSuffix, &process)
+
+		var pod sdElement
+		pod.add("namespace", p.Pod.GetNamespace())
+		pod.add("name", p.Pod.GetName())
+		pod.add("container", p.Pod.GetContainer().GetName())
+		pod.add("container_id", p.Pod.GetContainer().GetId())
+		pod.add("image", p.Pod.GetContainer().GetImage().GetName())
+		b = appendSD(b, "pod"+e.sdSuffix, &pod)
+	}
+
+	var policy sdElement
+	policy.add("name", ev.policy)
+	switch x := ev.res.Event.(type) {
+	case *fgs.GetEventsResponse_ProcessKprobe:
+		policy.add("function", x.ProcessKprobe.FunctionName)
+		if ev.action != fgs.KprobeAction_KPROBE_ACTION_UNKNOWN {
+			policy.add("action", ev.action.String())
+		}
+	case *fgs.GetEventsResponse_ProcessTracepoint:
+		policy.add("subsys", x.ProcessTracepoint.Subsys)
+		policy.add("event", x.ProcessTracepoint.Event)
+	}
+	b = appendSD(b, "policy"+e.sdSuffix, &policy)
+
+	if len(b) == start {
+		b = append(b, nilValue...)
+	}
+	b = append(b, ' ')
+	return appendText(b, ev.summary())
+}
+
+// cefSeverities maps syslog severities to CEF severities, from 0 to 10.
+var cefSeverities = [...]int{
+	SeverityEmergency: 10,
+	SeverityAlert:     9,
+	SeverityCritical:  8,
+	SeverityError:     7,
+	SeverityWarning:   5,
+	SeverityNotice:    3,
+	SeverityInfo:      1,
+	SeverityDebug:     0,
+}
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\n", " ", "\r", " ")
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\n", `\n`, "\r", `\r`)
+)
+
+// cefExtension builds the extension of a CEF message, skipping empty values.
+type cefExtension struct {
+	b []byte
+}
+
+func (ext *cefExtension) add(key, value string) {
+	if value == "" {
+		return
+	}
+	if len(ext.b) > 0 {
+		ext.b = append(ext.b, ' ')
+	}
+	ext.b = append(ext.b, key...)
+	ext.b = append(ext.b, '=')
+	ext.b = append(ext.b, cefExtensionEscaper.Replace(value)...)
+}
+
+// addCustom adds a custom string field, with its label.
+func (ext *cefExtension) addCustom(n int, label, value string) {
+	if value == "" {
+		return
+	}
+	key := "cs" + strconv.Itoa(n)
+	ext.add(key+"Label", label)
+	ext.add(key, value)
+}
+
+// appendCEF appends a CEF message, with an RFC 5424 header.
+func (e *Encoder) appendCEF(b []byte, ev *event, sev Severity) []byte {
+	b = e.appendHeader(b, ev, sev)
+	b = append(b, " - CEF:0|"...)
+	for _, field := range []string{cefVendor, cefProduct, version.Version, ev.eventType.String(), ev.summary()} {
+		b = append(b, cefHeaderEscaper.Replace(field)...)
+		b = append(b, '|')
+	}
+	b = strconv.AppendInt(b, int64(cefSeverities[sev]), 10)
+	b = append(b, '|')
+
+	var ext cefExtension
+	ext.add("rt", strconv.FormatInt(ev.time.UnixNano()/int64(time.Millisecond), 10))
+	ext.add("dvchost", ev.res.NodeName)
+	if p := ev.process; p != nil {
+		if p.Pid != nil {
+			ext.add("spid", strconv.FormatUint(uint64(p.Pid.Value), 10))
+		}
+		if p.Uid != nil {
+			ext.add("suid", strconv.FormatUint(uint64(p.Uid.Value), 10))
+		}
+		ext.add("sproc", p.Binary)
+		ext.addCustom(1, "namespace", p.Pod.GetNamespace())
+		ext.addCustom(2, "pod", p.Pod.GetName())
+		ext.addCustom(3, "container", p.Pod.GetContainer().GetName())
+		ext.addCustom(4, "execId", p.ExecId)
+		ext.addCustom(5, "arguments", p.Arguments)
+	}
+	ext.addCustom(6, "policy", ev.policy)
+	if ev.action != fgs.KprobeAction_KPROBE_ACTION_UNKNOWN {
+		ext.add("act", ev.action.String())
+	}
+	return append(b, ext.b...)
+}
@@ -0,0 +1,297 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package syslog encodes events as RFC 5424 syslog messages, or as ArcSight
+// CEF messages, for SIEM integration.
+package syslog
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+)
+
+const (
+	FormatRFC5424 = "rfc5424"
+	FormatCEF     = "cef"
+
+	// FramingOctetCounting prefixes messages with their length (RFC 6587).
+	// It is the framing of stream sockets, as required by RFC 5425 for TLS.
+	FramingOctetCounting = "octet-counting"
+	// FramingNewline terminates messages with a new line.
+	FramingNewline = "newline"
+	// FramingNone writes messages as they are, for datagram sockets.
+	FramingNone = "none"
+
+	defaultAppName = "tetragon"
+	// defaultEnterpriseNumber is the private enterprise number that RFC 5612
+	// reserves for documentation.
+	defaultEnterpriseNumber = 32473
+)
+
+// Severity is a syslog severity. Lower values are more severe.
+type Severity int
+
+const (
+	SeverityEmergency Severity = iota
+	SeverityAlert
+	SeverityCritical
+	SeverityError
+	SeverityWarning
+	SeverityNotice
+	SeverityInfo
+	SeverityDebug
+)
+
+var severityNames = map[string]Severity{
+	"emergency": SeverityEmergency,
+	"emerg":     SeverityEmergency,
+	"alert":     SeverityAlert,
+	"critical":  SeverityCritical,
+	"crit":      SeverityCritical,
+	"error":     SeverityError,
+	"err":       SeverityError,
+	"warning":   SeverityWarning,
+	"warn":      SeverityWarning,
+	"notice":    SeverityNotice,
+	"info":      SeverityInfo,
+	"debug":     SeverityDebug,
+}
+
+// ParseSeverity parses a severity name, such as warning or crit.
+func ParseSeverity(name string) (Severity, error) {
+	if s, ok := severityNames[strings.ToLower(name)]; ok {
+		return s, nil
+	}
+	return 0, fmt.Errorf("unknown syslog severity %q", name)
+}
+
+var facilityNames = map[string]int{
+	"kern":     0,
+	"user":     1,
+	"mail":     2,
+	"daemon":   3,
+	"auth":     4,
+	"syslog":   5,
+	"lpr":      6,
+	"news":     7,
+	"uucp":     8,
+	"cron":     9,
+	"authpriv": 10,
+	"ftp":      11,
+	"local0":   16,
+	"local1":   17,
+	"local2":   18,
+	"local3":   19,
+	"local4":   20,
+	"local5":   21,
+	"local6":   22,
+	"local7":   23,
+}
+
+// SeverityConfig configures the severity of the messages.
+type SeverityConfig struct {
+	// Default severity of the events. Defaults to info.
+	Default string `json:"default,omitempty"`
+	// EventTypes maps event types, such as PROCESS_KPROBE, to severities.
+	EventTypes map[string]string `json:"eventTypes,omitempty"`
+	// Policies maps tracing policy names to the severity of their events.
+	// They take precedence over event types.
+	Policies map[string]string `json:"policies,omitempty"`
+	// Actions maps kprobe actions, such as KPROBE_ACTION_SIGKILL, to the
+	// minimum severity of their events, so that enforcement stands out.
+	// Defaults to critical for KPROBE_ACTION_SIGKILL and error for
+	// KPROBE_ACTION_OVERRIDE.
+	Actions map[string]string `json:"actions,omitempty"`
+}
+
+// Config configures the syslog encoding.
+type Config struct {
+	// Format is rfc5424 (default) or cef. CEF messages are sent with an
+	// RFC 5424 header.
+	Format string `json:"format,omitempty"`
+	// Facility of the messages, such as daemon (default) or local0.
+	Facility string `json:"facility,omitempty"`
+	// AppName of the messages. Defaults to tetragon.
+	AppName string `json:"appName,omitempty"`
+	// Hostname of the messages. Defaults to the node name of the events.
+	Hostname string `json:"hostname,omitempty"`
+	// Framing is octet-counting, newline or none. The default depends on
+	// the sink.
+	Framing string `json:"framing,omitempty"`
+	// EnterpriseNumber is the private enterprise number of the structured
+	// data IDs, such as process@32473. Defaults to 32473.
+	EnterpriseNumber uint32         `json:"enterpriseNumber,omitempty"`
+	Severity         SeverityConfig `json:"severity,omitempty"`
+}
+
+// severities resolves the severity of events.
+type severities struct {
+	def        Severity
+	eventTypes map[fgs.EventType]Severity
+	policies   map[string]Severity
+	actions    map[fgs.KprobeAction]Severity
+}
+
+func compileSeverities(cfg *SeverityConfig) (*severities, error) {
+	s := &severities{
+		def:        SeverityInfo,
+		eventTypes: map[fgs.EventType]Severity{},
+		policies:   map[string]Severity{},
+		actions: map[fgs.KprobeAction]Severity{
+			fgs.KprobeAction_KPROBE_ACTION_SIGKILL:  SeverityCritical,
+			fgs.KprobeAction_KPROBE_ACTION_OVERRIDE: SeverityError,
+		},
+	}
+	var err error
+	if cfg.Default != "" {
+		if s.def, err = ParseSeverity(cfg.Default); err != nil {
+			return nil, err
+		}
+	}
+	for name, sev := range cfg.EventTypes {
+		eventType, ok := fgs.EventType_value[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown event type %q", name)
+		}
+		if s.eventTypes[fgs.EventType(eventType)], err = ParseSeverity(sev); err != nil {
+			return nil, err
+		}
+	}
+	for name, sev := range cfg.Policies {
+		if s.policies[name], err = ParseSeverity(sev); err != nil {
+			return nil, err
+		}
+	}
+	for name, sev := range cfg.Actions {
+		action, ok := fgs.KprobeAction_value[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown kprobe action %q", name)
+		}
+		if s.actions[fgs.KprobeAction(action)], err = ParseSeverity(sev); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// severity returns the severity of an event: the severity of its policy, or
+// else of its type, raised to the severity of its action.
+func (s *severities) severity(ev *event) Severity {
+	sev := s.def
+	if eventSev, ok := s.eventTypes[ev.eventType]; ok {
+		sev = eventSev
+	}
+	if policySev, ok := s.policies[ev.policy]; ok && ev.policy != "" {
+		sev = policySev
+	}
+	if actionSev, ok := s.actions[ev.action]; ok && actionSev < sev {
+		sev = actionSev
+	}
+	return sev
+}
+
+// Validate checks the configuration.
+func (c *Config) Validate() error {
+	_, err := NewEncoder(nil, c, FramingNone)
+	return err
+}
+
+// Encoder encodes events as syslog messages. Each message is written with a
+// single Write call, so that datagram sinks send one message per datagram.
+type Encoder struct {
+	w          io.Writer
+	format     string
+	facility   int
+	appName    string
+	hostname   string
+	framing    string
+	sdSuffix   string
+	severities *severities
+	buf        bytes.Buffer
+}
+
+// NewEncoder creates a syslog encoder. defaultFraming is the framing used when
+// the configuration does not set one.
+func NewEncoder(w io.Writer, cfg *Config, defaultFraming string) (*Encoder, error) {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+	e := &Encoder{
+		w:        w,
+		format:   cfg.Format,
+		facility: facilityNames["daemon"],
+		appName:  cfg.AppName,
+		hostname: cfg.Hostname,
+		framing:  cfg.Framing,
+		sdSuffix: "@" + strconv.FormatUint(defaultEnterpriseNumber, 10),
+	}
+	switch e.format {
+	case "":
+		e.format = FormatRFC5424
+	case FormatRFC5424, FormatCEF:
+	default:
+		return nil, fmt.Errorf("unknown syslog format %q", cfg.Format)
+	}
+	if cfg.Facility != "" {
+		facility, ok := facilityNames[strings.ToLower(cfg.Facility)]
+		if !ok {
+			return nil, fmt.Errorf("unknown syslog facility %q", cfg.Facility)
+		}
+		e.facility = facility
+	}
+	if e.appName == "" {
+		e.appName = defaultAppName
+	}
+	switch e.framing {
+	case "":
+		e.framing = defaultFraming
+	case FramingOctetCounting, FramingNewline, FramingNone:
+	default:
+		return nil, fmt.Errorf("unknown syslog framing %q", cfg.Framing)
+	}
+	if cfg.EnterpriseNumber != 0 {
+		e.sdSuffix = "@" + strconv.FormatUint(uint64(cfg.EnterpriseNumber), 10)
+	}
+	var err error
+	if e.severities, err = compileSeverities(&cfg.Severity); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Encode writes an event as a syslog message.
+func (e *Encoder) Encode(v interface{}) error {
+	res, ok := v.(*fgs.GetEventsResponse)
+	if !ok {
+		return fmt.Errorf("syslog encoder cannot encode %T", v)
+	}
+	ev := newEvent(res)
+	sev := e.severities.severity(ev)
+
+	var msg []byte
+	if e.format == FormatCEF {
+		msg = e.appendCEF(nil, ev, sev)
+	} else {
+		msg = e.appendRFC5424(nil, ev, sev)
+	}
+
+	e.buf.Reset()
+	switch e.framing {
+	case FramingOctetCounting:
+		e.buf.WriteString(strconv.Itoa(len(msg)))
+		e.buf.WriteByte(' ')
+		e.buf.Write(msg)
+	case FramingNewline:
+		e.buf.Write(msg)
+		e.buf.WriteByte('\n')
+	default:
+		e.buf.Write(msg)
+	}
+	_, err := e.w.Write(e.buf.Bytes())
+	return err
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package syslog
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var eventTime = time.Date(2022, 5, 17, 10, 30, 0, 123456789, time.UTC)
+
+func testProcess() *fgs.Process {
+	return &fgs.Process{
+		ExecId:    "exec-id",
+		Pid:       wrapperspb.UInt32(42),
+		Uid:       wrapperspb.UInt32(0),
+		Binary:    "/usr/bin/curl",
+		Arguments: `-H "a]b" cilium.io`,
+		Pod: &fgs.Pod{
+			Namespace: "default",
+			Name:      "curl-pod",
+		},
+	}
+}
+
+func execEvent() *fgs.GetEventsResponse {
+	return &fgs.GetEventsResponse{
+		Event:    &fgs.GetEventsResponse_ProcessExec{ProcessExec: &fgs.ProcessExec{Process: testProcess()}},
+		NodeName: "node-1",
+		Time:     timestamppb.New(eventTime),
+	}
+}
+
+func kprobeEvent(policy string, action fgs.KprobeAction) *fgs.GetEventsResponse {
+	return &fgs.GetEventsResponse{
+		Event: &fgs.GetEventsResponse_ProcessKprobe{ProcessKprobe: &fgs.ProcessKprobe{
+			Process:      testProcess(),
+			FunctionName: "__x64_sys_write",
+			PolicyName:   policy,
+			Action:       action,
+		}},
+		NodeName: "node-1",
+		Time:     timestamppb.New(eventTime),
+	}
+}
+
+func encode(t *testing.T, cfg *Config, framing string, res *fgs.GetEventsResponse) string {
+	var buf bytes.Buffer
+	e, err := NewEncoder(&buf, cfg, framing)
+	require.NoError(t, err)
+	require.NoError(t, e.Encode(res))
+	return buf.String()
+}
+
+func TestRFC5424(t *testing.T) {
+	msg := encode(t, &Config{}, FramingNone, execEvent())
+	assert.Equal(t, `<30>1 2022-05-17T10:30:00.123456Z node-1 tetragon - PROCESS_EXEC `+
+		`[event@32473 node="node-1"]`+
+		`[process@32473 exec_id="exec-id" pid="42" uid="0" binary="/usr/bin/curl" arguments="-H \"a\]b\" cilium.io"]`+
+		`[pod@32473 namespace="default" name="curl-pod"] `+
+		`process_exec /usr/bin/curl -H "a]b" cilium.io`, msg)
+
+	msg = encode(t, &Config{Facility: "local0", Hostname: "my host", EnterpriseNumber: 1}, FramingNone,
+		&fgs.GetEventsResponse{Event: &fgs.GetEventsResponse_LostEvents{LostEvents: &fgs.LostEvents{Count: 3}}, Time: timestamppb.New(eventTime)})
+	assert.Equal(t, `<134>1 2022-05-17T10:30:00.123456Z my_host tetragon - LOST_EVENTS - lost_events 3`, msg)
+}
+
+func TestFraming(t *testing.T) {
+	msg := encode(t, &Config{}, FramingNone, execEvent())
+	assert.Equal(t, msg+"\n", encode(t, &Config{}, FramingNewline, execEvent()))
+	assert.Equal(t, strconv.Itoa(len(msg))+" "+msg, encode(t, &Config{}, FramingOctetCounting, execEvent()))
+	assert.Equal(t, msg+"\n", encode(t, &Config{Framing: FramingNewline}, FramingOctetCounting, execEvent()))
+}
+
+func TestCEF(t *testing.T) {
+	msg := encode(t, &Config{Format: FormatCEF}, FramingNone, kprobeEvent("block-writes", fgs.KprobeAction_KPROBE_ACTION_SIGKILL))
+	assert.Equal(t, `<26>1 2022-05-17T10:30:00.123456Z node-1 tetragon - PROCESS_KPROBE - `+
+		`CEF:0|Isovalent|Tetragon||PROCESS_KPROBE|process_kprobe /usr/bin/curl __x64_sys_write|8|`+
+		`rt=1652783400123 dvchost=node-1 spid=42 suid=0 sproc=/usr/bin/curl `+
+		`cs1Label=namespace cs1=default cs2Label=pod cs2=curl-pod cs4Label=execId cs4=exec-id `+
+		`cs5Label=arguments cs5=-H "a]b" cilium.io cs6Label=policy cs6=block-writes act=KPROBE_ACTION_SIGKILL`, msg)
+}
+
+func TestCEFEscaping(t *testing.T) {
+	res := execEvent()
+	res.GetProcessExec().Process.Binary = `/tmp/a|b`
+	res.GetProcessExec().Process.Arguments = "x=y\\z\nw"
+	msg := encode(t, &Config{Format: FormatCEF}, FramingNone, res)
+	assert.Contains(t, msg, `|process_exec /tmp/a\|b x=y\\z w|`)
+	assert.Contains(t, msg, ` cs5=x\=y\\z\nw`)
+}
+
+func TestRFC5424Escaping(t *testing.T) {
+	res := execEvent()
+	res.GetProcessExec().Process.Arguments = "a\n<13>1 forged\r\x00b"
+	msg := encode(t, &Config{}, FramingNewline, res)
+	assert.Contains(t, msg, ` arguments="a <13>1 forged  b"]`)
+	assert.True(t, strings.HasSuffix(msg, "] process_exec /usr/bin/curl a <13>1 forged  b\n"), msg)
+	assert.Equal(t, 1, strings.Count(msg, "\n"))
+}
+
+func TestSeverity(t *testing.T) {
+	cfg := &Config{Severity: SeverityConfig{
+		Default:    "notice",
+		EventTypes: map[string]string{"PROCESS_KPROBE": "warning"},
+		Policies:   map[string]string{"sensitive-files": "alert"},
+		Actions:    map[string]string{"KPROBE_ACTION_OVERRIDE": "crit"},
+	}}
+	e, err := NewEncoder(nil, cfg, FramingNone)
+	require.NoError(t, err)
+
+	severity := func(res *fgs.GetEventsResponse) Severity {
+		return e.severities.severity(newEvent(res))
+	}
+	assert.Equal(t, SeverityNotice, severity(execEvent()))
+	assert.Equal(t, SeverityWarning, severity(kprobeEvent("", fgs.KprobeAction_KPROBE_ACTION_POST)))
+	assert.Equal(t, SeverityAlert, severity(kprobeEvent("sensitive-files", fgs.KprobeAction_KPROBE_ACTION_POST)))
+	// enforcement actions raise the severity, but do not lower it
+	assert.Equal(t, SeverityCritical, severity(kprobeEvent("", fgs.KprobeAction_KPROBE_ACTION_SIGKILL)))
+	assert.Equal(t, SeverityCritical, severity(kprobeEvent("", fgs.KprobeAction_KPROBE_ACTION_OVERRIDE)))
+	assert.Equal(t, SeverityAlert, severity(kprobeEvent("sensitive-files", fgs.KprobeAction_KPROBE_ACTION_SIGKILL)))
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, (&Config{}).Validate())
+	for _, cfg := range []*Config{
+		{Format: "leef"},
+		{Facility: "local8"},
+		{Framing: "length"},
+		{Severity: SeverityConfig{Default: "urgent"}},
+		{Severity: SeverityConfig{EventTypes: map[string]string{"PROCESS_FORK": "info"}}},
+		{Severity: SeverityConfig{Policies: map[string]string{"p": "urgent"}}},
+		{Severity: SeverityConfig{Actions: map[string]string{"SIGKILL": "alert"}}},
+	} {
+		assert.Error(t, cfg.Validate(), "%+v", cfg)
+	}
+}
@@ -0,0 +1,260 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package fieldmask trims the fields of GetEventsResponse events according to
+// the field masks of a GetEvents request.
+//
+// Events are shared between all the GetEvents listeners, so masks never
+// modify them in place. Instead, the messages along the masked paths are
+// copied and the rest of the event is shared with the original.
+package fieldmask
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const eventOneof = "event"
+
+// pathTree is a set of field paths, stored as a tree of field names. A node
+// without children selects the whole field.
+type pathTree map[protoreflect.Name]pathTree
+
+func (t pathTree) add(md protoreflect.MessageDescriptor, path string) error {
+	names := strings.Split(path, ".")
+	node := t
+	for i, name := range names {
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return fmt.Errorf("%s has no field %q", md.FullName(), name)
+		}
+		last := i == len(names)-1
+		if !last && (fd.Message() == nil || fd.IsList() || fd.IsMap()) {
+			return fmt.Errorf("field %q of %s is not a message", name, md.FullName())
+		}
+
+		child, exists := node[fd.Name()]
+		switch {
+		case exists && len(child) == 0:
+			// the whole field is already selected
+			return nil
+		case last || !exists:
+			child = pathTree{}
+			node[fd.Name()] = child
+		}
+		node = child
+		md = fd.Message()
+	}
+	return nil
+}
+
+// shallowCopy returns a copy of m that shares its fields with m.
+func shallowCopy(m protoreflect.Message) protoreflect.Message {
+	dst := m.New()
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		dst.Set(fd, v)
+		return true
+	})
+	return dst
+}
+
+func include(m protoreflect.Message, t pathTree) protoreflect.Message {
+	dst := m.New()
+	fields := m.Descriptor().Fields()
+	for name, child := range t {
+		fd := fields.ByName(name)
+		if !m.Has(fd) {
+			continue
+		}
+		if len(child) == 0 {
+			dst.Set(fd, m.Get(fd))
+		} else {
+			dst.Set(fd, protoreflect.ValueOfMessage(include(m.Get(fd).Message(), child)))
+		}
+	}
+	return dst
+}
+
+func exclude(m protoreflect.Message, t pathTree) protoreflect.Message {
+	dst := shallowCopy(m)
+	fields := m.Descriptor().Fields()
+	for name, child := range t {
+		fd := fields.ByName(name)
+		if !m.Has(fd) {
+			continue
+		}
+		if len(child) == 0 {
+			dst.Clear(fd)
+		} else {
+			dst.Set(fd, protoreflect.ValueOfMessage(exclude(m.Get(fd).Message(), child)))
+		}
+	}
+	return dst
+}
+
+// eventMask is the compiled form of an fgs.EventFieldMask for a single event
+// type.
+type eventMask struct {
+	include pathTree
+	exclude pathTree
+}
+
+func (em *eventMask) apply(m protoreflect.Message) protoreflect.Message {
+	if em.include != nil {
+		m = include(m, em.include)
+	}
+	if em.exclude != nil {
+		m = exclude(m, em.exclude)
+	}
+	return m
+}
+
+// FieldMasks is the compiled form of the field masks of a GetEvents request.
+type FieldMasks struct {
+	// masks indexed by the name of the event field in GetEventsResponse
+	masks map[protoreflect.Name][]*eventMask
+}
+
+// eventFields returns the fields of the GetEventsResponse event oneof for
+// the given event types, or all of them if types is empty.
+func eventFields(types []fgs.EventType) ([]protoreflect.FieldDescriptor, error) {
+	oneof := (&fgs.GetEventsResponse{}).ProtoReflect().Descriptor().Oneofs().ByName(eventOneof)
+
+	var ret []protoreflect.FieldDescriptor
+	if len(types) == 0 {
+		for i := 0; i < oneof.Fields().Len(); i++ {
+			ret = append(ret, oneof.Fields().Get(i))
+		}
+		return ret, nil
+	}
+	for _, t := range types {
+		fd := oneof.Fields().ByName(protoreflect.Name(strings.ToLower(t.String())))
+		if fd == nil {
+			return nil, fmt.Errorf("unknown event type %s", t)
+		}
+		ret = append(ret, fd)
+	}
+	return ret, nil
+}
+
+// compilePaths builds the path tree of paths for event message md. Paths
+// that do not exist in md are skipped if skipUnknown is set, and the set of
+// paths that were added is returned.
+func compilePaths(md protoreflect.MessageDescriptor, paths []string, skipUnknown bool) (pathTree, map[string]bool, error) {
+	t := pathTree{}
+	added := map[string]bool{}
+	for _, p := range paths {
+		if err := t.add(md, p); err != nil {
+			if skipUnknown {
+				continue
+			}
+			return nil, nil, err
+		}
+		added[p] = true
+	}
+	return t, added, nil
+}
+
+// New compiles the field masks of a GetEvents request. When a mask applies
+// to several event types, each path needs to exist in at least one of them.
+func New(masks []*fgs.EventFieldMask) (*FieldMasks, error) {
+	if len(masks) == 0 {
+		return nil, nil
+	}
+
+	ret := &FieldMasks{masks: map[protoreflect.Name][]*eventMask{}}
+	for _, m := range masks {
+		fields, err := eventFields(m.EventSet)
+		if err != nil {
+			return nil, err
+		}
+		includePaths := m.GetInclude().GetPaths()
+		excludePaths := m.GetExclude().GetPaths()
+		skipUnknown := len(fields) > 1
+		found := map[string]bool{}
+		for _, fd := range fields {
+			em := &eventMask{}
+			if m.Include != nil {
+				t, added, err := compilePaths(fd.Message(), includePaths, skipUnknown)
+				if err != nil {
+					return nil, fmt.Errorf("invalid include path: %w", err)
+				}
+				for p := range added {
+					found[p] = true
+				}
+				em.include = t
+			}
+			if m.Exclude != nil {
+				t, added, err := compilePaths(fd.Message(), excludePaths, skipUnknown)
+				if err != nil {
+					return nil, fmt.Errorf("invalid exclude path: %w", err)
+				}
+				for p := range added {
+					found[p] = true
+				}
+				em.exclude = t
+			}
+			ret.masks[fd.Name()] = append(ret.masks[fd.Name()], em)
+		}
+		for _, paths := range [][]string{includePaths, excludePaths} {
+			for _, p := range paths {
+				if !found[p] {
+					return nil, fmt.Errorf("invalid path %q: no such field in the selected event types", p)
+				}
+			}
+		}
+	}
+	return ret, nil
+}
+
+// Apply returns the response with the field masks applied. The response is
+// not modified: if any mask applies, a new response that shares the
+// unmodified fields with the original one is returned.
+func (fm *FieldMasks) Apply(res *fgs.GetEventsResponse) *fgs.GetEventsResponse {
+	if fm == nil || res == nil {
+		return res
+	}
+	m := res.ProtoReflect()
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName(eventOneof))
+	if fd == nil {
+		return res
+	}
+	masks := fm.masks[fd.Name()]
+	if len(masks) == 0 {
+		return res
+	}
+
+	ev := m.Get(fd).Message()
+	for _, em := range masks {
+		ev = em.apply(ev)
+	}
+	dst := shallowCopy(m)
+	dst.Set(fd, protoreflect.ValueOfMessage(ev))
+	return dst.Interface().(*fgs.GetEventsResponse)
+}
+
+// ParseFieldMaskList parses a list of field masks in JSON format into
+// protobuf messages.
+func ParseFieldMaskList(masks string) ([]*fgs.EventFieldMask, error) {
+	if masks == "" {
+		return nil, nil
+	}
+	dec := json.NewDecoder(strings.NewReader(masks))
+	var results []*fgs.EventFieldMask
+	for {
+		var result fgs.EventFieldMask
+		if err := dec.Decode(&result); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		results = append(results, &result)
+	}
+	return results, nil
+}
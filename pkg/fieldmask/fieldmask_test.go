@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package fieldmask
+
+import (
+	"testing"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func testExec() *fgs.GetEventsResponse {
+	return &fgs.GetEventsResponse{
+		NodeName: "node-1",
+		Event: &fgs.GetEventsResponse_ProcessExec{
+			ProcessExec: &fgs.ProcessExec{
+				Process: &fgs.Process{
+					Binary:    "/bin/bash",
+					Arguments: "-c true",
+					Pod:       &fgs.Pod{Name: "pod", Namespace: "default", Labels: []string{"app=test"}},
+					Cap:       &fgs.Capabilities{Permitted: []fgs.CapabilitiesType{fgs.CapabilitiesType_CAP_CHOWN}},
+				},
+				Parent: &fgs.Process{Binary: "/usr/sbin/sshd"},
+			},
+		},
+	}
+}
+
+func testKprobe() *fgs.GetEventsResponse {
+	return &fgs.GetEventsResponse{
+		Event: &fgs.GetEventsResponse_ProcessKprobe{
+			ProcessKprobe: &fgs.ProcessKprobe{
+				Process:      &fgs.Process{Binary: "/usr/bin/curl"},
+				Parent:       &fgs.Process{Binary: "/bin/bash"},
+				FunctionName: "tcp_connect",
+			},
+		},
+	}
+}
+
+func TestInclude(t *testing.T) {
+	fm, err := New([]*fgs.EventFieldMask{{
+		EventSet: []fgs.EventType{fgs.EventType_PROCESS_EXEC},
+		Include:  &fieldmaskpb.FieldMask{Paths: []string{"process.binary", "process.pod.name", "process.pod"}},
+	}})
+	require.NoError(t, err)
+
+	ev := testExec()
+	orig := proto.Clone(ev)
+	res := fm.Apply(ev)
+	assert.True(t, proto.Equal(orig, ev), "event was modified")
+	assert.True(t, proto.Equal(&fgs.GetEventsResponse{
+		NodeName: "node-1",
+		Event: &fgs.GetEventsResponse_ProcessExec{
+			ProcessExec: &fgs.ProcessExec{
+				Process: &fgs.Process{
+					Binary: "/bin/bash",
+					Pod:    &fgs.Pod{Name: "pod", Namespace: "default", Labels: []string{"app=test"}},
+				},
+			},
+		},
+	}, res), res.String())
+
+	// other event types are not affected
+	kp := testKprobe()
+	assert.Same(t, kp, fm.Apply(kp))
+}
+
+func TestExclude(t *testing.T) {
+	fm, err := New([]*fgs.EventFieldMask{{
+		Exclude: &fieldmaskpb.FieldMask{Paths: []string{"parent", "process.cap", "process.pod.labels"}},
+	}})
+	require.NoError(t, err)
+
+	ev := testExec()
+	orig := proto.Clone(ev)
+	res := fm.Apply(ev)
+	assert.True(t, proto.Equal(orig, ev), "event was modified")
+	assert.True(t, proto.Equal(&fgs.GetEventsResponse{
+		NodeName: "node-1",
+		Event: &fgs.GetEventsResponse_ProcessExec{
+			ProcessExec: &fgs.ProcessExec{
+				Process: &fgs.Process{
+					Binary:    "/bin/bash",
+					Arguments: "-c true",
+					Pod:       &fgs.Pod{Name: "pod", Namespace: "default"},
+				},
+			},
+		},
+	}, res), res.String())
+
+	kp := testKprobe()
+	orig = proto.Clone(kp)
+	res = fm.Apply(kp)
+	assert.True(t, proto.Equal(orig, kp), "event was modified")
+	assert.Nil(t, res.GetProcessKprobe().Parent)
+	assert.Equal(t, "/usr/bin/curl", res.GetProcessKprobe().Process.Binary)
+}
+
+func TestIncludeExclude(t *testing.T) {
+	fm, err := New([]*fgs.EventFieldMask{
+		{Include: &fieldmaskpb.FieldMask{Paths: []string{"process"}}},
+		{
+			EventSet: []fgs.EventType{fgs.EventType_PROCESS_EXEC},
+			Exclude:  &fieldmaskpb.FieldMask{Paths: []string{"process.pod"}},
+		},
+	})
+	require.NoError(t, err)
+
+	res := fm.Apply(testExec())
+	assert.Nil(t, res.GetProcessExec().Parent)
+	assert.Nil(t, res.GetProcessExec().Process.Pod)
+	assert.Equal(t, "/bin/bash", res.GetProcessExec().Process.Binary)
+
+	res = fm.Apply(testKprobe())
+	assert.Nil(t, res.GetProcessKprobe().Parent)
+	assert.Empty(t, res.GetProcessKprobe().FunctionName)
+	assert.Equal(t, "/usr/bin/curl", res.GetProcessKprobe().Process.Binary)
+}
+
+func TestInvalid(t *testing.T) {
+	for _, m := range []*fgs.EventFieldMask{
+		{Include: &fieldmaskpb.FieldMask{Paths: []string{"nosuchfield"}}},
+		{Exclude: &fieldmaskpb.FieldMask{Paths: []string{"process.binary.foo"}}},
+		{
+			EventSet: []fgs.EventType{fgs.EventType_PROCESS_EXIT},
+			Include:  &fieldmaskpb.FieldMask{Paths: []string{"function_name"}},
+		},
+		{
+			EventSet: []fgs.EventType{fgs.EventType_UNDEF},
+			Include:  &fieldmaskpb.FieldMask{Paths: []string{"process"}},
+		},
+	} {
+		_, err := New([]*fgs.EventFieldMask{m})
+		assert.Error(t, err, m.String())
+	}
+
+	fm, err := New(nil)
+	assert.NoError(t, err)
+	ev := testExec()
+	assert.Same(t, ev, fm.Apply(ev))
+}
+
+func TestParseFieldMaskList(t *testing.T) {
+	masks, err := ParseFieldMaskList(`{"event_set": ["PROCESS_EXEC"], "exclude": "parent"} {"include": "process,parent.binary"}`)
+	require.NoError(t, err)
+	require.Len(t, masks, 2)
+	assert.Equal(t, []fgs.EventType{fgs.EventType_PROCESS_EXEC}, masks[0].EventSet)
+	assert.Equal(t, []string{"parent"}, masks[0].Exclude.Paths)
+	assert.Equal(t, []string{"process", "parent.binary"}, masks[1].Include.Paths)
+}
@@ -0,0 +1,300 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+
+	v1 "github.com/cilium/hubble/pkg/api/v1"
+	hubbleFilters "github.com/cilium/hubble/pkg/filters"
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+)
+
+// probeEvent holds the fields of kprobe and tracepoint events that argument
+// aware filters match against.
+type probeEvent struct {
+	functionName string
+	policyName   string
+	args         []*fgs.KprobeArgument
+}
+
+func getProbeEvent(ev *v1.Event) *probeEvent {
+	if ev == nil {
+		return nil
+	}
+	response, ok := ev.Event.(*fgs.GetEventsResponse)
+	if !ok {
+		return nil
+	}
+	switch event := response.Event.(type) {
+	case *fgs.GetEventsResponse_ProcessKprobe:
+		kprobe := event.ProcessKprobe
+		if kprobe == nil {
+			return nil
+		}
+		return &probeEvent{
+			functionName: kprobe.FunctionName,
+			policyName:   kprobe.PolicyName,
+			args:         kprobe.Args,
+		}
+	case *fgs.GetEventsResponse_ProcessTracepoint:
+		tp := event.ProcessTracepoint
+		if tp == nil {
+			return nil
+		}
+		return &probeEvent{
+			functionName: tp.Subsys + "/" + tp.Event,
+			policyName:   tp.PolicyName,
+			args:         tp.Args,
+		}
+	}
+	return nil
+}
+
+func filterByFunctionName(names []string) hubbleFilters.FilterFunc {
+	return func(ev *v1.Event) bool {
+		probe := getProbeEvent(ev)
+		if probe == nil {
+			return false
+		}
+		for _, name := range names {
+			if name == probe.functionName {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+type FunctionNameFilter struct{}
+
+func (f *FunctionNameFilter) OnBuildFilter(_ context.Context, ff *fgs.Filter) ([]hubbleFilters.FilterFunc, error) {
+	var fs []hubbleFilters.FilterFunc
+	if ff.FunctionName != nil {
+		fs = append(fs, filterByFunctionName(ff.FunctionName))
+	}
+	return fs, nil
+}
+
+func filterByPolicyName(names []string) hubbleFilters.FilterFunc {
+	return func(ev *v1.Event) bool {
+		probe := getProbeEvent(ev)
+		if probe == nil {
+			return false
+		}
+		for _, name := range names {
+			if name == probe.policyName {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+type PolicyNameFilter struct{}
+
+func (f *PolicyNameFilter) OnBuildFilter(_ context.Context, ff *fgs.Filter) ([]hubbleFilters.FilterFunc, error) {
+	var fs []hubbleFilters.FilterFunc
+	if ff.PolicyName != nil {
+		fs = append(fs, filterByPolicyName(ff.PolicyName))
+	}
+	return fs, nil
+}
+
+// parseCIDRs parses a list of IP addresses and CIDRs. IP addresses are
+// converted to single address networks.
+func parseCIDRs(cidrs []string) ([]*net.IPNet, error) {
+	var ret []*net.IPNet
+	for _, c := range cidrs {
+		if _, ipnet, err := net.ParseCIDR(c); err == nil {
+			ret = append(ret, ipnet)
+			continue
+		}
+		ip := net.ParseIP(c)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid IP address or CIDR %q", c)
+		}
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip = ip4
+			bits = 8 * net.IPv4len
+		}
+		ret = append(ret, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+	}
+	return ret, nil
+}
+
+func matchCIDRs(cidrs []*net.IPNet, addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, cidr := range cidrs {
+		if cidr.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchPorts(ports []uint32, port uint32) bool {
+	for _, p := range ports {
+		if p == port {
+			return true
+		}
+	}
+	return false
+}
+
+// argMatcher is the compiled form of fgs.ArgumentFilter.
+type argMatcher struct {
+	index        *uint32
+	regex        []*regexp.Regexp
+	saddr, daddr []*net.IPNet
+	sport, dport []uint32
+}
+
+func newArgMatcher(af *fgs.ArgumentFilter) (*argMatcher, error) {
+	m := &argMatcher{
+		sport: af.Sport,
+		dport: af.Dport,
+	}
+	if af.Index != nil {
+		index := af.Index.Value
+		m.index = &index
+	}
+	for _, pattern := range af.Regex {
+		query, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile regexp: %v", err)
+		}
+		m.regex = append(m.regex, query)
+	}
+	var err error
+	if m.saddr, err = parseCIDRs(af.Saddr); err != nil {
+		return nil, fmt.Errorf("failed to parse saddr: %v", err)
+	}
+	if m.daddr, err = parseCIDRs(af.Daddr); err != nil {
+		return nil, fmt.Errorf("failed to parse daddr: %v", err)
+	}
+	return m, nil
+}
+
+func (m *argMatcher) matchString(s string) bool {
+	for _, query := range m.regex {
+		if query.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *argMatcher) matchAddrs(saddr, daddr string, sport, dport uint32) bool {
+	if m.saddr != nil && !matchCIDRs(m.saddr, saddr) {
+		return false
+	}
+	if m.daddr != nil && !matchCIDRs(m.daddr, daddr) {
+		return false
+	}
+	if m.sport != nil && !matchPorts(m.sport, sport) {
+		return false
+	}
+	if m.dport != nil && !matchPorts(m.dport, dport) {
+		return false
+	}
+	return true
+}
+
+func (m *argMatcher) hasAddrs() bool {
+	return m.saddr != nil || m.daddr != nil || m.sport != nil || m.dport != nil
+}
+
+// match returns true if all the predicates of the matcher hold for arg. A
+// predicate that does not apply to the type of arg does not match.
+func (m *argMatcher) match(arg *fgs.KprobeArgument) bool {
+	if m.regex != nil {
+		var s string
+		switch a := arg.Arg.(type) {
+		case *fgs.KprobeArgument_StringArg:
+			s = a.StringArg
+		case *fgs.KprobeArgument_PathArg:
+			s = a.PathArg.GetPath()
+		case *fgs.KprobeArgument_FileArg:
+			s = a.FileArg.GetPath()
+		default:
+			return false
+		}
+		if !m.matchString(s) {
+			return false
+		}
+	}
+	if m.hasAddrs() {
+		switch a := arg.Arg.(type) {
+		case *fgs.KprobeArgument_SockArg:
+			sock := a.SockArg
+			return m.matchAddrs(sock.GetSaddr(), sock.GetDaddr(), sock.GetSport(), sock.GetDport())
+		case *fgs.KprobeArgument_SkbArg:
+			skb := a.SkbArg
+			return m.matchAddrs(skb.GetSaddr(), skb.GetDaddr(), skb.GetSport(), skb.GetDport())
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func (m *argMatcher) matchArgs(args []*fgs.KprobeArgument) bool {
+	if m.index != nil {
+		if int(*m.index) >= len(args) {
+			return false
+		}
+		return m.match(args[*m.index])
+	}
+	for _, arg := range args {
+		if m.match(arg) {
+			return true
+		}
+	}
+	return false
+}
+
+func filterByArgs(argFilters []*fgs.ArgumentFilter) (hubbleFilters.FilterFunc, error) {
+	var matchers []*argMatcher
+	for _, af := range argFilters {
+		m, err := newArgMatcher(af)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, m)
+	}
+	return func(ev *v1.Event) bool {
+		probe := getProbeEvent(ev)
+		if probe == nil {
+			return false
+		}
+		for _, m := range matchers {
+			if !m.matchArgs(probe.args) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+type ArgFilter struct{}
+
+func (f *ArgFilter) OnBuildFilter(_ context.Context, ff *fgs.Filter) ([]hubbleFilters.FilterFunc, error) {
+	var fs []hubbleFilters.FilterFunc
+	if ff.ArgFilter != nil {
+		argFilter, err := filterByArgs(ff.ArgFilter)
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, argFilter)
+	}
+	return fs, nil
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/cilium/hubble/pkg/api/v1"
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func kprobeEvent(function, policy string, args ...*fgs.KprobeArgument) *v1.Event {
+	return &v1.Event{
+		Event: &fgs.GetEventsResponse{
+			Event: &fgs.GetEventsResponse_ProcessKprobe{
+				ProcessKprobe: &fgs.ProcessKprobe{
+					FunctionName: function,
+					PolicyName:   policy,
+					Args:         args,
+				},
+			},
+		},
+	}
+}
+
+func sockArg(daddr string, dport uint32) *fgs.KprobeArgument {
+	return &fgs.KprobeArgument{Arg: &fgs.KprobeArgument_SockArg{SockArg: &fgs.KprobeSock{
+		Saddr: "10.0.0.1",
+		Sport: 40000,
+		Daddr: daddr,
+		Dport: dport,
+	}}}
+}
+
+func TestFunctionNameFilter(t *testing.T) {
+	f := []*fgs.Filter{{FunctionName: []string{"tcp_connect", "syscalls/sys_enter_lseek"}}}
+	fl, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&FunctionNameFilter{}})
+	assert.NoError(t, err)
+	assert.True(t, fl.MatchOne(kprobeEvent("tcp_connect", "")))
+	assert.False(t, fl.MatchOne(kprobeEvent("tcp_close", "")))
+	assert.True(t, fl.MatchOne(&v1.Event{Event: &fgs.GetEventsResponse{
+		Event: &fgs.GetEventsResponse_ProcessTracepoint{
+			ProcessTracepoint: &fgs.ProcessTracepoint{Subsys: "syscalls", Event: "sys_enter_lseek"},
+		},
+	}}))
+	assert.False(t, fl.MatchOne(podEvent(nil)))
+}
+
+func TestPolicyNameFilter(t *testing.T) {
+	f := []*fgs.Filter{{PolicyName: []string{"connect"}}}
+	fl, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&PolicyNameFilter{}})
+	assert.NoError(t, err)
+	assert.True(t, fl.MatchOne(kprobeEvent("tcp_connect", "connect")))
+	assert.False(t, fl.MatchOne(kprobeEvent("tcp_connect", "other")))
+	assert.False(t, fl.MatchOne(podEvent(nil)))
+}
+
+func TestArgFilter(t *testing.T) {
+	pathArg := &fgs.KprobeArgument{Arg: &fgs.KprobeArgument_PathArg{PathArg: &fgs.KprobePath{Path: "/etc/passwd"}}}
+	fileArg := &fgs.KprobeArgument{Arg: &fgs.KprobeArgument_FileArg{FileArg: &fgs.KprobeFile{Path: "/etc/shadow"}}}
+	stringArg := &fgs.KprobeArgument{Arg: &fgs.KprobeArgument_StringArg{StringArg: "/etc/hosts"}}
+	intArg := &fgs.KprobeArgument{Arg: &fgs.KprobeArgument_IntArg{IntArg: 3}}
+
+	// regex on any argument
+	f := []*fgs.Filter{{ArgFilter: []*fgs.ArgumentFilter{{Regex: []string{"^/etc/(passwd|shadow)$"}}}}}
+	fl, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&ArgFilter{}})
+	assert.NoError(t, err)
+	assert.True(t, fl.MatchOne(kprobeEvent("", "", intArg, pathArg)))
+	assert.True(t, fl.MatchOne(kprobeEvent("", "", fileArg)))
+	assert.False(t, fl.MatchOne(kprobeEvent("", "", stringArg, intArg)))
+	assert.False(t, fl.MatchOne(kprobeEvent("", "")))
+	assert.False(t, fl.MatchOne(podEvent(nil)))
+
+	// regex on a given argument
+	f = []*fgs.Filter{{ArgFilter: []*fgs.ArgumentFilter{{Index: wrapperspb.UInt32(1), Regex: []string{"^/etc/"}}}}}
+	fl, err = BuildFilterList(context.Background(), f, []OnBuildFilter{&ArgFilter{}})
+	assert.NoError(t, err)
+	assert.True(t, fl.MatchOne(kprobeEvent("", "", intArg, stringArg)))
+	assert.False(t, fl.MatchOne(kprobeEvent("", "", stringArg, intArg)))
+	assert.False(t, fl.MatchOne(kprobeEvent("", "", stringArg)))
+
+	// destination CIDRs and ports
+	f = []*fgs.Filter{{ArgFilter: []*fgs.ArgumentFilter{{Daddr: []string{"0.0.0.0/0", "::/0"}, Dport: []uint32{443}}}}}
+	fl, err = BuildFilterList(context.Background(), f, []OnBuildFilter{&ArgFilter{}})
+	assert.NoError(t, err)
+	assert.True(t, fl.MatchOne(kprobeEvent("", "", sockArg("1.1.1.1", 443))))
+	assert.True(t, fl.MatchOne(kprobeEvent("", "", sockArg("2001:db8::1", 443))))
+	assert.False(t, fl.MatchOne(kprobeEvent("", "", sockArg("1.1.1.1", 80))))
+	assert.False(t, fl.MatchOne(kprobeEvent("", "", pathArg)))
+
+	f = []*fgs.Filter{{ArgFilter: []*fgs.ArgumentFilter{{Daddr: []string{"10.0.0.0/8", "192.168.1.1"}}}}}
+	fl, err = BuildFilterList(context.Background(), f, []OnBuildFilter{&ArgFilter{}})
+	assert.NoError(t, err)
+	assert.True(t, fl.MatchOne(kprobeEvent("", "", sockArg("10.1.2.3", 80))))
+	assert.True(t, fl.MatchOne(kprobeEvent("", "", sockArg("192.168.1.1", 80))))
+	assert.False(t, fl.MatchOne(kprobeEvent("", "", sockArg("192.168.1.2", 80))))
+	assert.True(t, fl.MatchOne(kprobeEvent("", "", &fgs.KprobeArgument{Arg: &fgs.KprobeArgument_SkbArg{SkbArg: &fgs.KprobeSkb{Daddr: "10.0.0.2"}}})))
+
+	// all argument filters must match
+	f = []*fgs.Filter{{ArgFilter: []*fgs.ArgumentFilter{{Regex: []string{"passwd"}}, {Dport: []uint32{443}}}}}
+	fl, err = BuildFilterList(context.Background(), f, []OnBuildFilter{&ArgFilter{}})
+	assert.NoError(t, err)
+	assert.True(t, fl.MatchOne(kprobeEvent("", "", pathArg, sockArg("1.1.1.1", 443))))
+	assert.False(t, fl.MatchOne(kprobeEvent("", "", pathArg)))
+
+	// invalid filters
+	for _, af := range []*fgs.ArgumentFilter{{Regex: []string{"("}}, {Saddr: []string{"10.0.0.0/33"}}, {Daddr: []string{"foo"}}} {
+		f = []*fgs.Filter{{ArgFilter: []*fgs.ArgumentFilter{af}}}
+		_, err = BuildFilterList(context.Background(), f, []OnBuildFilter{&ArgFilter{}})
+		assert.Error(t, err)
+	}
+}
+
+func TestArgFilterExportAllowlist(t *testing.T) {
+	f, err := ParseFilterList(`{"arg_filter": [{"daddr": ["0.0.0.0/0"], "dport": [443]}]}`)
+	assert.NoError(t, err)
+	fl, err := BuildFilterList(context.Background(), f, Filters)
+	assert.NoError(t, err)
+	assert.True(t, fl.MatchOne(kprobeEvent("tcp_connect", "", sockArg("1.1.1.1", 443))))
+	assert.False(t, fl.MatchOne(kprobeEvent("tcp_connect", "", sockArg("1.1.1.1", 80))))
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/cilium/hubble/pkg/api/v1"
+	hubbleFilters "github.com/cilium/hubble/pkg/filters"
+	"github.com/google/cel-go/cel"
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/isovalent/tetragon-oss/pkg/logger"
+)
+
+// celEventVariable is the name of the variable that holds the
+// GetEventsResponse in CEL expressions.
+const celEventVariable = "event"
+
+func newCelEnv() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Types(&fgs.GetEventsResponse{}),
+		cel.Variable(celEventVariable, cel.ObjectType("fgs.GetEventsResponse")),
+	)
+}
+
+func compileCelExpression(env *cel.Env, expr string) (cel.Program, error) {
+	ast, issues := env.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("failed to compile CEL expression %q: %v", expr, issues.Err())
+	}
+	if !ast.OutputType().IsAssignableType(cel.BoolType) {
+		return nil, fmt.Errorf("CEL expression %q must return a bool, not %s", expr, ast.OutputType())
+	}
+	prg, err := env.Program(ast, cel.EvalOptions(cel.OptOptimize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create program for CEL expression %q: %v", expr, err)
+	}
+	return prg, nil
+}
+
+func filterByCelExpression(exprs []string) (hubbleFilters.FilterFunc, error) {
+	env, err := newCelEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %v", err)
+	}
+	var prgs []cel.Program
+	for _, expr := range exprs {
+		prg, err := compileCelExpression(env, expr)
+		if err != nil {
+			return nil, err
+		}
+		prgs = append(prgs, prg)
+	}
+	return func(ev *v1.Event) bool {
+		response, ok := ev.Event.(*fgs.GetEventsResponse)
+		if !ok {
+			return false
+		}
+		vars := map[string]interface{}{celEventVariable: response}
+		for _, prg := range prgs {
+			out, _, err := prg.Eval(vars)
+			if err != nil {
+				logger.GetLogger().WithError(err).Debug("Failed to evaluate CEL expression")
+				continue
+			}
+			if match, ok := out.Value().(bool); ok && match {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+type CelExpressionFilter struct{}
+
+func (f *CelExpressionFilter) OnBuildFilter(_ context.Context, ff *fgs.Filter) ([]hubbleFilters.FilterFunc, error) {
+	var fs []hubbleFilters.FilterFunc
+	if ff.CelExpression != nil {
+		celFilter, err := filterByCelExpression(ff.CelExpression)
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, celFilter)
+	}
+	return fs, nil
+}
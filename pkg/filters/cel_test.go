@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/cilium/hubble/pkg/api/v1"
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/stretchr/testify/assert"
+)
+
+func execEvent(binary, parentBinary, namespace string) *v1.Event {
+	return &v1.Event{
+		Event: &fgs.GetEventsResponse{
+			Event: &fgs.GetEventsResponse_ProcessExec{
+				ProcessExec: &fgs.ProcessExec{
+					Process: &fgs.Process{Binary: binary, Pod: &fgs.Pod{Namespace: namespace}},
+					Parent:  &fgs.Process{Binary: parentBinary},
+				},
+			},
+		},
+	}
+}
+
+func TestCelExpressionFilter(t *testing.T) {
+	f := []*fgs.Filter{{CelExpression: []string{
+		`event.process_exec.process.binary in ["/bin/sh", "/bin/bash"] && ` +
+			`event.process_exec.parent.binary.endsWith("/nginx") && ` +
+			`event.process_exec.process.pod.namespace == "web"`,
+	}}}
+	fl, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&CelExpressionFilter{}})
+	assert.NoError(t, err)
+	assert.True(t, fl.MatchOne(execEvent("/bin/bash", "/usr/sbin/nginx", "web")))
+	assert.False(t, fl.MatchOne(execEvent("/bin/bash", "/usr/sbin/nginx", "default")))
+	assert.False(t, fl.MatchOne(execEvent("/bin/bash", "/usr/bin/containerd-shim", "web")))
+	assert.False(t, fl.MatchOne(execEvent("/usr/bin/curl", "/usr/sbin/nginx", "web")))
+	assert.False(t, fl.MatchOne(kprobeEvent("tcp_connect", "")))
+
+	f = []*fgs.Filter{{CelExpression: []string{
+		`has(event.process_kprobe) && event.process_kprobe.function_name == "tcp_connect"`,
+		`event.process_exec.process.binary == "/usr/bin/curl"`,
+	}}}
+	fl, err = BuildFilterList(context.Background(), f, []OnBuildFilter{&CelExpressionFilter{}})
+	assert.NoError(t, err)
+	assert.True(t, fl.MatchOne(kprobeEvent("tcp_connect", "")))
+	assert.True(t, fl.MatchOne(execEvent("/usr/bin/curl", "", "")))
+	assert.False(t, fl.MatchOne(kprobeEvent("tcp_close", "")))
+}
+
+func TestCelExpressionFilterInvalid(t *testing.T) {
+	for _, expr := range []string{
+		`event.process_exec.process.binary ==`,
+		`event.process_exec.process.no_such_field == "foo"`,
+		`event.process_exec.process.binary`,
+	} {
+		f := []*fgs.Filter{{CelExpression: []string{expr}}}
+		_, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&CelExpressionFilter{}})
+		assert.Error(t, err, expr)
+	}
+
+	f, err := ParseFilterList(`{"cel_expression": ["event.process_exec.process.binary == 1"]}`)
+	assert.NoError(t, err)
+	_, err = BuildFilterList(context.Background(), f, Filters)
+	assert.Error(t, err)
+}
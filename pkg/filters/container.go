@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	v1 "github.com/cilium/hubble/pkg/api/v1"
+	hubbleFilters "github.com/cilium/hubble/pkg/filters"
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+)
+
+func getContainer(ev *v1.Event) *fgs.Container {
+	process := GetProcess(ev)
+	if process == nil || process.Pod == nil {
+		return nil
+	}
+	return process.Pod.Container
+}
+
+func filterByContainerId(ids []string) hubbleFilters.FilterFunc {
+	return func(ev *v1.Event) bool {
+		container := getContainer(ev)
+		if container == nil || container.Id == "" {
+			return false
+		}
+		// container IDs are reported with their runtime prefix, for
+		// example docker://<id>
+		id := container.Id
+		if idx := strings.Index(id, "://"); idx >= 0 {
+			id = id[idx+3:]
+		}
+		for _, prefix := range ids {
+			if prefix != "" && (strings.HasPrefix(id, prefix) || strings.HasPrefix(container.Id, prefix)) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+type ContainerIdFilter struct{}
+
+func (f *ContainerIdFilter) OnBuildFilter(_ context.Context, ff *fgs.Filter) ([]hubbleFilters.FilterFunc, error) {
+	var fs []hubbleFilters.FilterFunc
+	if ff.ContainerId != nil {
+		fs = append(fs, filterByContainerId(ff.ContainerId))
+	}
+	return fs, nil
+}
+
+func filterByContainerImageRegex(imagePatterns []string) (hubbleFilters.FilterFunc, error) {
+	var images []*regexp.Regexp
+	for _, pattern := range imagePatterns {
+		query, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile regexp: %v", err)
+		}
+		images = append(images, query)
+	}
+	return func(ev *v1.Event) bool {
+		container := getContainer(ev)
+		if container == nil || container.Image == nil {
+			return false
+		}
+		for _, image := range images {
+			if image.MatchString(container.Image.Name) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+type ContainerImageRegexFilter struct{}
+
+func (f *ContainerImageRegexFilter) OnBuildFilter(_ context.Context, ff *fgs.Filter) ([]hubbleFilters.FilterFunc, error) {
+	var fs []hubbleFilters.FilterFunc
+	if ff.ContainerImageRegex != nil {
+		imageFilter, err := filterByContainerImageRegex(ff.ContainerImageRegex)
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, imageFilter)
+	}
+	return fs, nil
+}
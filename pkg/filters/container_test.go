@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainerIdFilter(t *testing.T) {
+	f := []*fgs.Filter{{ContainerId: []string{"3f4a2c"}}}
+	fl, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&ContainerIdFilter{}})
+	assert.NoError(t, err)
+	assert.True(t, fl.MatchOne(podEvent(&fgs.Pod{Container: &fgs.Container{Id: "docker://3f4a2c91b0e7"}})))
+	assert.True(t, fl.MatchOne(podEvent(&fgs.Pod{Container: &fgs.Container{Id: "3f4a2c91b0e7"}})))
+	assert.False(t, fl.MatchOne(podEvent(&fgs.Pod{Container: &fgs.Container{Id: "containerd://91b0e73f4a2c"}})))
+	assert.False(t, fl.MatchOne(podEvent(&fgs.Pod{})))
+	assert.False(t, fl.MatchOne(podEvent(nil)))
+}
+
+func TestContainerImageRegexFilter(t *testing.T) {
+	f := []*fgs.Filter{{ContainerImageRegex: []string{"^docker.io/library/nginx:"}}}
+	fl, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&ContainerImageRegexFilter{}})
+	assert.NoError(t, err)
+	assert.True(t, fl.MatchOne(podEvent(&fgs.Pod{Container: &fgs.Container{Image: &fgs.Image{Name: "docker.io/library/nginx:1.21"}}})))
+	assert.False(t, fl.MatchOne(podEvent(&fgs.Pod{Container: &fgs.Container{Image: &fgs.Image{Name: "quay.io/nginx:1.21"}}})))
+	assert.False(t, fl.MatchOne(podEvent(&fgs.Pod{Container: &fgs.Container{}})))
+	assert.False(t, fl.MatchOne(podEvent(nil)))
+}
@@ -96,6 +96,16 @@ var Filters = []OnBuildFilter{
 	&PidFilter{},
 	&PidSetFilter{},
 	&EventTypeFilter{},
+	&PodRegexFilter{},
+	&LabelsFilter{},
+	&ContainerIdFilter{},
+	&ContainerImageRegexFilter{},
+	&NodeNameFilter{},
+	&FunctionNameFilter{},
+	&PolicyNameFilter{},
+	&ArgFilter{},
+	&CelExpressionFilter{},
+	&NetworkExpressionFilter{},
 }
 
 func GetProcess(event *v1.Event) *fgs.Process {
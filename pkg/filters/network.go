@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	v1 "github.com/cilium/hubble/pkg/api/v1"
+	hubbleFilters "github.com/cilium/hubble/pkg/filters"
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/isovalent/tetragon-oss/pkg/vtuple"
+	"github.com/isovalent/tetragon-oss/pkg/vtuplefilter"
+)
+
+func sockProtocol(protocol string) byte {
+	switch protocol {
+	case "IPPROTO_TCP":
+		return vtuple.VT_TCP
+	case "IPPROTO_UDP":
+		return vtuple.VT_UDP
+	}
+	return 0
+}
+
+// argVTuple returns the tuple of sock and skb arguments.
+func argVTuple(arg *fgs.KprobeArgument) (vtuple.VTuple, bool) {
+	var proto byte
+	var saddr, daddr string
+	var sport, dport uint32
+
+	switch a := arg.Arg.(type) {
+	case *fgs.KprobeArgument_SockArg:
+		sock := a.SockArg
+		proto = sockProtocol(sock.GetProtocol())
+		saddr, daddr = sock.GetSaddr(), sock.GetDaddr()
+		sport, dport = sock.GetSport(), sock.GetDport()
+	case *fgs.KprobeArgument_SkbArg:
+		skb := a.SkbArg
+		proto = byte(skb.GetProto())
+		saddr, daddr = skb.GetSaddr(), skb.GetDaddr()
+		sport, dport = skb.GetSport(), skb.GetDport()
+	default:
+		return nil, false
+	}
+
+	t, err := vtuple.CreateVTuple(proto, net.ParseIP(saddr), uint16(sport), net.ParseIP(daddr), uint16(dport))
+	if err != nil {
+		return nil, false
+	}
+	return &t, true
+}
+
+func filterByNetworkExpression(exprs []string) (hubbleFilters.FilterFunc, error) {
+	var nfs []vtuplefilter.Filter
+	for _, expr := range exprs {
+		nf, err := vtuplefilter.FromExpression(expr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse network expression %q: %v", expr, err)
+		}
+		nfs = append(nfs, nf)
+	}
+	return func(ev *v1.Event) bool {
+		probe := getProbeEvent(ev)
+		if probe == nil {
+			return false
+		}
+		for _, arg := range probe.args {
+			t, ok := argVTuple(arg)
+			if !ok {
+				continue
+			}
+			for _, nf := range nfs {
+				if nf.FilterFn(t) {
+					return true
+				}
+			}
+		}
+		return false
+	}, nil
+}
+
+type NetworkExpressionFilter struct{}
+
+func (f *NetworkExpressionFilter) OnBuildFilter(_ context.Context, ff *fgs.Filter) ([]hubbleFilters.FilterFunc, error) {
+	var fs []hubbleFilters.FilterFunc
+	if ff.NetworkExpression != nil {
+		networkFilter, err := filterByNetworkExpression(ff.NetworkExpression)
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, networkFilter)
+	}
+	return fs, nil
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkExpressionFilter(t *testing.T) {
+	tcpSock := &fgs.KprobeArgument{Arg: &fgs.KprobeArgument_SockArg{SockArg: &fgs.KprobeSock{
+		Protocol: "IPPROTO_TCP", Saddr: "10.0.0.1", Sport: 40000, Daddr: "1.1.1.1", Dport: 443,
+	}}}
+	udpSock := &fgs.KprobeArgument{Arg: &fgs.KprobeArgument_SockArg{SockArg: &fgs.KprobeSock{
+		Protocol: "IPPROTO_UDP", Saddr: "10.0.0.1", Sport: 40000, Daddr: "1.1.1.1", Dport: 443,
+	}}}
+	tcpSkb := &fgs.KprobeArgument{Arg: &fgs.KprobeArgument_SkbArg{SkbArg: &fgs.KprobeSkb{
+		Proto: 6, Saddr: "10.0.0.1", Sport: 40000, Daddr: "1.1.1.1", Dport: 443,
+	}}}
+	tcpSock6 := &fgs.KprobeArgument{Arg: &fgs.KprobeArgument_SockArg{SockArg: &fgs.KprobeSock{
+		Protocol: "IPPROTO_TCP", Saddr: "2001:db8::1", Sport: 40000, Daddr: "2001:db8::2", Dport: 443,
+	}}}
+
+	f := []*fgs.Filter{{NetworkExpression: []string{"tcp and dport 443"}}}
+	fl, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&NetworkExpressionFilter{}})
+	assert.NoError(t, err)
+	assert.True(t, fl.MatchOne(kprobeEvent("tcp_connect", "", tcpSock)))
+	assert.True(t, fl.MatchOne(kprobeEvent("ip_output", "", tcpSkb)))
+	assert.True(t, fl.MatchOne(kprobeEvent("tcp_connect", "", tcpSock6)))
+	assert.False(t, fl.MatchOne(kprobeEvent("udp_sendmsg", "", udpSock)))
+	assert.False(t, fl.MatchOne(kprobeEvent("tcp_connect", "")))
+	assert.False(t, fl.MatchOne(podEvent(nil)))
+
+	f = []*fgs.Filter{{NetworkExpression: []string{"ip6", "udp"}}}
+	fl, err = BuildFilterList(context.Background(), f, []OnBuildFilter{&NetworkExpressionFilter{}})
+	assert.NoError(t, err)
+	assert.True(t, fl.MatchOne(kprobeEvent("tcp_connect", "", tcpSock6)))
+	assert.True(t, fl.MatchOne(kprobeEvent("udp_sendmsg", "", udpSock)))
+	assert.False(t, fl.MatchOne(kprobeEvent("tcp_connect", "", tcpSock)))
+
+	f = []*fgs.Filter{{NetworkExpression: []string{"tcp and dport"}}}
+	_, err = BuildFilterList(context.Background(), f, []OnBuildFilter{&NetworkExpressionFilter{}})
+	assert.Error(t, err)
+}
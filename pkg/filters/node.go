@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+
+	v1 "github.com/cilium/hubble/pkg/api/v1"
+	hubbleFilters "github.com/cilium/hubble/pkg/filters"
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+)
+
+func filterByNodeName(nodeNames []string) hubbleFilters.FilterFunc {
+	return func(ev *v1.Event) bool {
+		if ev == nil {
+			return false
+		}
+		response, ok := ev.Event.(*fgs.GetEventsResponse)
+		if !ok {
+			return false
+		}
+		for _, nodeName := range nodeNames {
+			if nodeName == response.NodeName {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+type NodeNameFilter struct{}
+
+func (f *NodeNameFilter) OnBuildFilter(_ context.Context, ff *fgs.Filter) ([]hubbleFilters.FilterFunc, error) {
+	var fs []hubbleFilters.FilterFunc
+	if ff.NodeName != nil {
+		fs = append(fs, filterByNodeName(ff.NodeName))
+	}
+	return fs, nil
+}
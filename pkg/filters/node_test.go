@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/cilium/hubble/pkg/api/v1"
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeNameFilter(t *testing.T) {
+	f := []*fgs.Filter{{NodeName: []string{"node-1", "node-2"}}}
+	fl, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&NodeNameFilter{}})
+	assert.NoError(t, err)
+	assert.True(t, fl.MatchOne(&v1.Event{Event: &fgs.GetEventsResponse{NodeName: "node-1"}}))
+	assert.True(t, fl.MatchOne(&v1.Event{Event: &fgs.GetEventsResponse{NodeName: "node-2"}}))
+	assert.False(t, fl.MatchOne(&v1.Event{Event: &fgs.GetEventsResponse{NodeName: "node-3"}}))
+	assert.False(t, fl.MatchOne(&v1.Event{Event: &fgs.GetEventsResponse{}}))
+}
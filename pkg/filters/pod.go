@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	v1 "github.com/cilium/hubble/pkg/api/v1"
+	hubbleFilters "github.com/cilium/hubble/pkg/filters"
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func filterByPodRegex(podPatterns []string) (hubbleFilters.FilterFunc, error) {
+	var pods []*regexp.Regexp
+	for _, pattern := range podPatterns {
+		query, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile regexp: %v", err)
+		}
+		pods = append(pods, query)
+	}
+	return func(ev *v1.Event) bool {
+		process := GetProcess(ev)
+		if process == nil || process.Pod == nil {
+			return false
+		}
+		for _, pod := range pods {
+			if pod.MatchString(process.Pod.Name) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+type PodRegexFilter struct{}
+
+func (f *PodRegexFilter) OnBuildFilter(_ context.Context, ff *fgs.Filter) ([]hubbleFilters.FilterFunc, error) {
+	var fs []hubbleFilters.FilterFunc
+	if ff.PodRegex != nil {
+		podFilters, err := filterByPodRegex(ff.PodRegex)
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, podFilters)
+	}
+	return fs, nil
+}
+
+// podLabels converts pod labels from their "key=value" representation in
+// fgs.Pod into a label set.
+func podLabels(pod *fgs.Pod) labels.Set {
+	set := labels.Set{}
+	for _, l := range pod.Labels {
+		kv := strings.SplitN(l, "=", 2)
+		if len(kv) == 2 {
+			set[kv[0]] = kv[1]
+		} else {
+			set[kv[0]] = ""
+		}
+	}
+	return set
+}
+
+func filterByLabels(selectors []string) (hubbleFilters.FilterFunc, error) {
+	var sels []labels.Selector
+	for _, s := range selectors {
+		sel, err := labels.Parse(s)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse label selector %q: %v", s, err)
+		}
+		sels = append(sels, sel)
+	}
+	return func(ev *v1.Event) bool {
+		process := GetProcess(ev)
+		if process == nil || process.Pod == nil {
+			return false
+		}
+		set := podLabels(process.Pod)
+		for _, sel := range sels {
+			if sel.Matches(set) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+type LabelsFilter struct{}
+
+func (f *LabelsFilter) OnBuildFilter(_ context.Context, ff *fgs.Filter) ([]hubbleFilters.FilterFunc, error) {
+	var fs []hubbleFilters.FilterFunc
+	if ff.Labels != nil {
+		labelsFilter, err := filterByLabels(ff.Labels)
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, labelsFilter)
+	}
+	return fs, nil
+}
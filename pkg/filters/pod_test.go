@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/cilium/hubble/pkg/api/v1"
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/stretchr/testify/assert"
+)
+
+func podEvent(pod *fgs.Pod) *v1.Event {
+	return &v1.Event{
+		Event: &fgs.GetEventsResponse{
+			Event: &fgs.GetEventsResponse_ProcessExec{
+				ProcessExec: &fgs.ProcessExec{Process: &fgs.Process{Pod: pod}},
+			},
+		},
+	}
+}
+
+func TestPodRegexFilter(t *testing.T) {
+	f := []*fgs.Filter{{PodRegex: []string{"^nginx-", "^redis$"}}}
+	fl, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&PodRegexFilter{}})
+	assert.NoError(t, err)
+	assert.True(t, fl.MatchOne(podEvent(&fgs.Pod{Name: "nginx-7d8b49557c-abcde"})))
+	assert.True(t, fl.MatchOne(podEvent(&fgs.Pod{Name: "redis"})))
+	assert.False(t, fl.MatchOne(podEvent(&fgs.Pod{Name: "redis-0"})))
+	assert.False(t, fl.MatchOne(podEvent(nil)))
+
+	f = []*fgs.Filter{{PodRegex: []string{"("}}}
+	_, err = BuildFilterList(context.Background(), f, []OnBuildFilter{&PodRegexFilter{}})
+	assert.Error(t, err)
+}
+
+func TestLabelsFilter(t *testing.T) {
+	f := []*fgs.Filter{{Labels: []string{"app=nginx,tier in (frontend, backend)", "debug"}}}
+	fl, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&LabelsFilter{}})
+	assert.NoError(t, err)
+	assert.True(t, fl.MatchOne(podEvent(&fgs.Pod{Labels: []string{"app=nginx", "tier=frontend"}})))
+	assert.False(t, fl.MatchOne(podEvent(&fgs.Pod{Labels: []string{"app=nginx", "tier=db"}})))
+	assert.False(t, fl.MatchOne(podEvent(&fgs.Pod{Labels: []string{"app=nginx"}})))
+	assert.True(t, fl.MatchOne(podEvent(&fgs.Pod{Labels: []string{"debug=true"}})))
+	assert.False(t, fl.MatchOne(podEvent(&fgs.Pod{})))
+	assert.False(t, fl.MatchOne(podEvent(nil)))
+
+	f = []*fgs.Filter{{Labels: []string{"app in nginx"}}}
+	_, err = BuildFilterList(context.Background(), f, []OnBuildFilter{&LabelsFilter{}})
+	assert.Error(t, err)
+}
@@ -23,6 +23,12 @@ const (
 	GenericFileType     = 16
 	GenericFdType       = 17
 
+	GenericBpfAttr        = 18
+	GenericModuleType     = 19
+	GenericLoadInfoType   = 20
+	GenericLinuxBinprm    = 21
+	GenericCapabilityType = 22
+
 	GenericNopType     = -1
 	GenericInvalidType = -2
 )
@@ -61,6 +67,16 @@ func GenericTypeFromString(arg string) int {
 		return GenericFdType
 	case "cred":
 		return GenericCredType
+	case "bpf_attr":
+		return GenericBpfAttr
+	case "module":
+		return GenericModuleType
+	case "load_info":
+		return GenericLoadInfoType
+	case "linux_binprm":
+		return GenericLinuxBinprm
+	case "capability":
+		return GenericCapabilityType
 	case "nop":
 		return GenericNopType
 	default:
@@ -8,7 +8,9 @@ import (
 	"sync"
 
 	"github.com/cilium/hubble/pkg/cilium"
+	"github.com/google/uuid"
 	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/isovalent/tetragon-oss/pkg/api/lostapi"
 	"github.com/isovalent/tetragon-oss/pkg/api/processapi"
 	"github.com/isovalent/tetragon-oss/pkg/api/readyapi"
 	"github.com/isovalent/tetragon-oss/pkg/api/testapi"
@@ -25,6 +27,7 @@ import (
 	"github.com/isovalent/tetragon-oss/pkg/sensors"
 	"github.com/isovalent/tetragon-oss/pkg/server"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type execProcess interface {
@@ -52,6 +55,10 @@ type ProcessManager struct {
 	enableEventCache  bool
 	enableCilium      bool
 	dns               *dns.Cache
+	// agentID identifies this agent instance in the events, and sequence is
+	// the sequence number of the last event. Both are protected by mux.
+	agentID  string
+	sequence uint64
 }
 
 // NewProcessManager returns a pointer to an initialized ProcessManager struct.
@@ -73,6 +80,7 @@ func NewProcessManager(
 		enableProcessNs:   enableProcessNs,
 		enableEventCache:  enableEventCache,
 		enableCilium:      enableCilium,
+		agentID:           uuid.New().String(),
 	}
 
 	pm.dns, err = dns.NewCache()
@@ -90,6 +98,7 @@ func NewProcessManager(
 		"enableEventCache":  enableEventCache,
 		"enableProcessCred": enableProcessCred,
 		"enableProcessNs":   enableProcessNs,
+		"agentID":           pm.agentID,
 	}).Info("Starting process manager")
 	return pm, nil
 }
@@ -110,6 +119,8 @@ func (pm *ProcessManager) Notify(event interface{}) error {
 		processedEvent = tracingGrpc.HandleGenericTracepointMessage(msg)
 	case *testapi.MsgTestEventUnix:
 		processedEvent = test.HandleTestMessage(msg)
+	case *lostapi.MsgLostEvents:
+		processedEvent = pm.handleLostEvents(msg)
 
 	default:
 		logger.GetLogger().WithField("event", event).Warnf("unhandled event of type %T", msg)
@@ -122,6 +133,20 @@ func (pm *ProcessManager) Notify(event interface{}) error {
 	return nil
 }
 
+func (pm *ProcessManager) handleLostEvents(msg *lostapi.MsgLostEvents) *fgs.GetEventsResponse {
+	return &fgs.GetEventsResponse{
+		Event: &fgs.GetEventsResponse_LostEvents{
+			LostEvents: &fgs.LostEvents{
+				Cpu:    uint32(msg.Cpu),
+				Count:  msg.Count,
+				Sensor: msg.Sensor,
+			},
+		},
+		NodeName: pm.nodeName,
+		Time:     timestamppb.Now(),
+	}
+}
+
 // Close implements Listener.Close.
 func (pm *ProcessManager) Close() error {
 	return nil
@@ -144,6 +169,11 @@ func (pm *ProcessManager) RemoveListener(listener server.Listener) {
 func (pm *ProcessManager) NotifyListener(original interface{}, processed *fgs.GetEventsResponse) {
 	pm.mux.Lock()
 	defer pm.mux.Unlock()
+	// Sequence numbers are assigned here so that all the listeners see
+	// them in the same order.
+	pm.sequence++
+	processed.Sequence = pm.sequence
+	processed.AgentId = pm.agentID
 	for l := range pm.listeners {
 		l.Notify(processed)
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/isovalent/tetragon-oss/pkg/ktime"
 	"github.com/isovalent/tetragon-oss/pkg/logger"
 	"github.com/isovalent/tetragon-oss/pkg/process"
+	"github.com/isovalent/tetragon-oss/pkg/reader/bpf"
 	"github.com/isovalent/tetragon-oss/pkg/reader/caps"
 	"github.com/isovalent/tetragon-oss/pkg/reader/network"
 	"github.com/isovalent/tetragon-oss/pkg/reader/node"
@@ -49,6 +50,117 @@ func kprobeAction(act uint64) fgs.KprobeAction {
 	}
 }
 
+func getKprobeArgument(arg api.MsgGenericKprobeArg) *fgs.KprobeArgument {
+	a := &fgs.KprobeArgument{}
+	switch e := arg.(type) {
+	case api.MsgGenericKprobeArgInt:
+		a.Arg = &fgs.KprobeArgument_IntArg{IntArg: e.Value}
+	case api.MsgGenericKprobeArgSize:
+		a.Arg = &fgs.KprobeArgument_SizeArg{SizeArg: e.Value}
+	case api.MsgGenericKprobeArgString:
+		a.Arg = &fgs.KprobeArgument_StringArg{StringArg: e.Value}
+	case api.MsgGenericKprobeArgSock:
+		sockArg := &fgs.KprobeSock{
+			Family:   network.InetFamily(e.Family),
+			Type:     network.InetType(e.Type),
+			Protocol: network.InetProtocol(e.Protocol),
+			Mark:     e.Mark,
+			Priority: e.Priority,
+			Saddr:    e.Saddr,
+			Daddr:    e.Daddr,
+			Sport:    e.Sport,
+			Dport:    e.Dport,
+		}
+		a.Arg = &fgs.KprobeArgument_SockArg{SockArg: sockArg}
+	case api.MsgGenericKprobeArgSkb:
+		skbArg := &fgs.KprobeSkb{
+			Hash:        e.Hash,
+			Len:         e.Len,
+			Priority:    e.Priority,
+			Mark:        e.Mark,
+			Saddr:       e.Saddr,
+			Daddr:       e.Daddr,
+			Sport:       e.Sport,
+			Dport:       e.Dport,
+			Proto:       e.Proto,
+			SecPathLen:  e.SecPathLen,
+			SecPathOlen: e.SecPathOLen,
+		}
+		a.Arg = &fgs.KprobeArgument_SkbArg{SkbArg: skbArg}
+	case api.MsgGenericKprobeArgCred:
+		capsArg := &fgs.KprobeCred{
+			Permitted:   caps.GetCapabilitiesTypes(e.Permitted),
+			Effective:   caps.GetCapabilitiesTypes(e.Effective),
+			Inheritable: caps.GetCapabilitiesTypes(e.Inheritable),
+			Uid:         e.Uid,
+			Gid:         e.Gid,
+			Suid:        e.Suid,
+			Sgid:        e.Sgid,
+			Euid:        e.Euid,
+			Egid:        e.Egid,
+			Fsuid:       e.FSuid,
+			Fsgid:       e.FSgid,
+			Securebits:  e.SecureBits,
+		}
+		a.Arg = &fgs.KprobeArgument_CredArg{CredArg: capsArg}
+	case api.MsgGenericKprobeArgBpfAttr:
+		bpfAttrArg := &fgs.KprobeBpfAttr{
+			ProgType: bpf.GetProgType(e.ProgType),
+			InsnCnt:  e.InsnCnt,
+			ProgName: e.ProgName,
+		}
+		a.Arg = &fgs.KprobeArgument_BpfAttrArg{BpfAttrArg: bpfAttrArg}
+	case api.MsgGenericKprobeArgModule:
+		a.Arg = &fgs.KprobeArgument_ModuleArg{ModuleArg: &fgs.KprobeModule{Name: e.Name}}
+	case api.MsgGenericKprobeArgLinuxBinprm:
+		binprmArg := &fgs.KprobeLinuxBinprm{
+			Path:        e.Path,
+			Interpreter: e.Interpreter,
+		}
+		a.Arg = &fgs.KprobeArgument_LinuxBinprmArg{LinuxBinprmArg: binprmArg}
+	case api.MsgGenericKprobeArgCapability:
+		a.Arg = &fgs.KprobeArgument_CapabilityArg{CapabilityArg: fgs.CapabilitiesType(e.Value)}
+	case api.MsgGenericKprobeArgBytes:
+		if e.OrigSize > uint64(len(e.Value)) {
+			a.Arg = &fgs.KprobeArgument_TruncatedBytesArg{
+				TruncatedBytesArg: &fgs.KprobeTruncatedBytes{
+					OrigSize: e.OrigSize,
+					BytesArg: e.Value,
+				},
+			}
+		} else {
+			a.Arg = &fgs.KprobeArgument_BytesArg{BytesArg: e.Value}
+		}
+	case api.MsgGenericKprobeArgFile:
+		fileArg := &fgs.KprobeFile{
+			Path:     kprobePath(e.Value, e.Flags, e.ResolvedPath),
+			Flags:    path.FilePathFlagsToStr(e.Flags),
+			HostPath: e.HostPath,
+		}
+		a.Arg = &fgs.KprobeArgument_FileArg{FileArg: fileArg}
+	case api.MsgGenericKprobeArgPath:
+		pathArg := &fgs.KprobePath{
+			Path:     kprobePath(e.Value, e.Flags, e.ResolvedPath),
+			Flags:    path.FilePathFlagsToStr(e.Flags),
+			HostPath: e.HostPath,
+		}
+		a.Arg = &fgs.KprobeArgument_PathArg{PathArg: pathArg}
+	default:
+		logger.GetLogger().WithField("arg", e).Warnf("unexpected type: %T", e)
+	}
+	return a
+}
+
+// kprobePath returns the path of a file or path argument. If the path was
+// resolved in userspace, the resolved path is used instead of the path built in
+// BPF.
+func kprobePath(value string, flags uint32, resolved string) string {
+	if resolved != "" {
+		return resolved
+	}
+	return path.MarkUnresolvedPathComponents(path.GenPath(value), flags)
+}
+
 func (t *Grpc) GetProcessKprobe(event *api.MsgGenericKprobeUnix) *fgs.ProcessKprobe {
 	var fgsParent, fgsProcess *fgs.Process
 	var fgsArgs []*fgs.KprobeArgument
@@ -74,75 +186,7 @@ func (t *Grpc) GetProcessKprobe(event *api.MsgGenericKprobeUnix) *fgs.ProcessKpr
 	}
 
 	for _, arg := range event.Args {
-		a := &fgs.KprobeArgument{}
-		switch e := arg.(type) {
-		case api.MsgGenericKprobeArgInt:
-			a.Arg = &fgs.KprobeArgument_IntArg{IntArg: e.Value}
-		case api.MsgGenericKprobeArgSize:
-			a.Arg = &fgs.KprobeArgument_SizeArg{SizeArg: e.Value}
-		case api.MsgGenericKprobeArgString:
-			a.Arg = &fgs.KprobeArgument_StringArg{StringArg: e.Value}
-		case api.MsgGenericKprobeArgSock:
-			sockArg := &fgs.KprobeSock{
-				Family:   network.InetFamily(e.Family),
-				Type:     network.InetType(e.Type),
-				Protocol: network.InetProtocol(e.Protocol),
-				Mark:     e.Mark,
-				Priority: e.Priority,
-				Saddr:    e.Saddr,
-				Daddr:    e.Daddr,
-				Sport:    e.Sport,
-				Dport:    e.Dport,
-			}
-			a.Arg = &fgs.KprobeArgument_SockArg{SockArg: sockArg}
-		case api.MsgGenericKprobeArgSkb:
-			skbArg := &fgs.KprobeSkb{
-				Hash:        e.Hash,
-				Len:         e.Len,
-				Priority:    e.Priority,
-				Mark:        e.Mark,
-				Saddr:       e.Saddr,
-				Daddr:       e.Daddr,
-				Sport:       e.Sport,
-				Dport:       e.Dport,
-				Proto:       e.Proto,
-				SecPathLen:  e.SecPathLen,
-				SecPathOlen: e.SecPathOLen,
-			}
-			a.Arg = &fgs.KprobeArgument_SkbArg{SkbArg: skbArg}
-		case api.MsgGenericKprobeArgCred:
-			capsArg := &fgs.KprobeCred{
-				Permitted:   caps.GetCapabilitiesTypes(e.Permitted),
-				Effective:   caps.GetCapabilitiesTypes(e.Effective),
-				Inheritable: caps.GetCapabilitiesTypes(e.Inheritable),
-			}
-			a.Arg = &fgs.KprobeArgument_CredArg{CredArg: capsArg}
-		case api.MsgGenericKprobeArgBytes:
-			if e.OrigSize > uint64(len(e.Value)) {
-				a.Arg = &fgs.KprobeArgument_TruncatedBytesArg{
-					TruncatedBytesArg: &fgs.KprobeTruncatedBytes{
-						OrigSize: e.OrigSize,
-						BytesArg: e.Value,
-					},
-				}
-			} else {
-				a.Arg = &fgs.KprobeArgument_BytesArg{BytesArg: e.Value}
-			}
-		case api.MsgGenericKprobeArgFile:
-			fileArg := &fgs.KprobeFile{
-				Path:  path.MarkUnresolvedPathComponents(path.GenPath(e.Value), e.Flags),
-				Flags: path.FilePathFlagsToStr(e.Flags),
-			}
-			a.Arg = &fgs.KprobeArgument_FileArg{FileArg: fileArg}
-		case api.MsgGenericKprobeArgPath:
-			pathArg := &fgs.KprobePath{
-				Path:  path.MarkUnresolvedPathComponents(path.GenPath(e.Value), e.Flags),
-				Flags: path.FilePathFlagsToStr(e.Flags),
-			}
-			a.Arg = &fgs.KprobeArgument_PathArg{PathArg: pathArg}
-		default:
-			logger.GetLogger().WithField("arg", e).Warnf("unexpected type: %T", e)
-		}
+		a := getKprobeArgument(arg)
 		if arg.IsReturnArg() {
 			fgsReturnArg = a
 		} else {
@@ -157,6 +201,8 @@ func (t *Grpc) GetProcessKprobe(event *api.MsgGenericKprobeUnix) *fgs.ProcessKpr
 		Args:         fgsArgs,
 		Return:       fgsReturnArg,
 		Action:       kprobeAction(event.Action),
+		PolicyName:   event.PolicyName,
+		Message:      event.Message,
 	}
 
 	if t.eventCache.Needed(fgsProcess) {
@@ -217,17 +263,37 @@ func (t *Grpc) HandleGenericTracepointMessage(msg *api.MsgGenericTracepointUnix)
 				BytesArg: v,
 			}})
 
+		case int32:
+			fgsArgs = append(fgsArgs, &fgs.KprobeArgument{Arg: &fgs.KprobeArgument_IntArg{
+				IntArg: v,
+			}})
+
+		case uint32:
+			fgsArgs = append(fgsArgs, &fgs.KprobeArgument{Arg: &fgs.KprobeArgument_SizeArg{
+				SizeArg: uint64(v),
+			}})
+
+		case int64:
+			fgsArgs = append(fgsArgs, &fgs.KprobeArgument{Arg: &fgs.KprobeArgument_LongArg{
+				LongArg: v,
+			}})
+
+		case api.MsgGenericKprobeArg:
+			fgsArgs = append(fgsArgs, getKprobeArgument(v))
+
 		default:
 			logger.GetLogger().Warnf("handleGenericTracepointMessage: unhandled value: %+v (%T)", arg, arg)
 		}
 	}
 
 	fgsEvent := &fgs.ProcessTracepoint{
-		Process: fgsProcess,
-		Parent:  fgsParent,
-		Subsys:  msg.Subsys,
-		Event:   msg.Event,
-		Args:    fgsArgs,
+		Process:    fgsProcess,
+		Parent:     fgsParent,
+		Subsys:     msg.Subsys,
+		Event:      msg.Event,
+		Args:       fgsArgs,
+		PolicyName: msg.PolicyName,
+		Message:    msg.Message,
 	}
 
 	if t.eventCache.Needed(fgsProcess) {
@@ -15,7 +15,7 @@ const (
 	// Used to determine if CRD needs to be updated in cluster
 	//
 	// Developers: Bump patch for each change in the CRD schema.
-	CustomResourceDefinitionSchemaVersion = "1.3.4"
+	CustomResourceDefinitionSchemaVersion = "1.3.9"
 
 	CRDVersion = "v1alpha1"
 
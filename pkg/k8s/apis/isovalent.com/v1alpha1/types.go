@@ -49,6 +49,11 @@ type KProbeSpec struct {
 	// Name of the function to apply the kprobe spec to.
 	Call string `json:"call"`
 	// +kubebuilder:validation:Optional
+	// A Go template of the one-line message of the events in compact output,
+	// such as "{{.process.binary}} opened {{.args.path}}". It can start with
+	// an emoji.
+	Message string `json:"message"`
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:default=false
 	// Indicates whether to collect return value of the traced function.
 	Return bool `json:"return"`
@@ -71,7 +76,7 @@ type KProbeArg struct {
 	// +kubebuilder:validation:Minimum=0
 	// Position of the argument.
 	Index uint32 `json:"index"`
-	// +kubebuilder:validation:Enum=int;uint32;int32;uint64;int64;char_buf;char_iovec;size_t;skb;sock;string;fd;file;filename;path;nop;
+	// +kubebuilder:validation:Enum=int;uint32;int32;uint64;int64;char_buf;char_iovec;size_t;skb;sock;string;fd;file;filename;path;nop;cred;bpf_attr;module;load_info;linux_binprm;capability;
 	// Argument type.
 	Type string `json:"type"`
 	// +kubebuilder:validation:Optional
@@ -83,6 +88,14 @@ type KProbeArg struct {
 	// +kubebuilder:default=false
 	// This field is used only for char_buf and char_iovec types.
 	ReturnCopy bool `json:"returnCopy"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65536
+	// Maximum number of bytes to capture for this argument. When set, the
+	// buffer is sent in separate chunks and reassembled by the agent,
+	// allowing captures larger than the default 4KiB limit. This field is
+	// used only for char_buf and char_iovec types.
+	MaxData uint32 `json:"maxData"`
 }
 
 type BinarySelector struct {
@@ -209,6 +222,18 @@ type TracepointSpec struct {
 	// Tracepoint event
 	Event string `json:"event"`
 	// +kubebuilder:validation:Optional
+	// A Go template of the one-line message of the events in compact output,
+	// such as "{{.process.binary}} opened {{.args.path}}". It can start with
+	// an emoji.
+	Message string `json:"message"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=raw;btf
+	// Tracepoint type. If not set, the classic tracepoint is attached and its
+	// arguments are typed based on the tracefs format file. If set to raw or
+	// btf, a raw_tracepoint or tp_btf program is attached to the event and
+	// arguments are typed based on the kernel BTF.
+	Type string `json:"type"`
+	// +kubebuilder:validation:Optional
 	// A list of function arguments to include in the trace output.
 	Args []KProbeArg `json:"args"`
 	// +kubebuilder:validation:Optional
@@ -116,6 +116,11 @@ var (
 		Help:        "The total number of FGS ringbuf perf event error count.",
 		ConstLabels: nil,
 	}, nil)
+	ListenerEventsDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name:        MetricNamePrefix + "get_events_listener_dropped_total",
+		Help:        "The total number of events dropped for a GetEvents listener because it did not consume them fast enough.",
+		ConstLabels: nil,
+	}, []string{"listener"})
 	ProcessInfoErrors = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name:        MetricNamePrefix + "process_info_errors",
 		Help:        "The total of times we failed to fetch cached process info for a given event type.",
@@ -123,6 +128,34 @@ var (
 	}, []string{"event_type"})
 )
 
+// Exporter metrics
+var (
+	ExporterSpoolEvents = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: MetricNamePrefix + "exporter_spool_events",
+		Help: "The number of events in the disk spool of an exporter that were not delivered yet.",
+	}, []string{"exporter"})
+	ExporterSpoolBytes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: MetricNamePrefix + "exporter_spool_bytes",
+		Help: "The size on disk of the spool of an exporter.",
+	}, []string{"exporter"})
+	ExporterSpoolOldestEventAge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: MetricNamePrefix + "exporter_spool_oldest_event_age_seconds",
+		Help: "The age of the oldest event in the disk spool of an exporter, 0 if the spool is empty.",
+	}, []string{"exporter"})
+	ExporterSpoolDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: MetricNamePrefix + "exporter_spool_dropped_total",
+		Help: "The total number of events dropped from the disk spool of an exporter because it was full or corrupted.",
+	}, []string{"exporter"})
+)
+
+// Notifier metrics
+var (
+	NotifierNotifications = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: MetricNamePrefix + "notifier_notifications_total",
+		Help: "The total number of notifications of a webhook, by status: sent, failed, dropped or rate_limited.",
+	}, []string{"webhook", "status"})
+)
+
 // DNS metrics
 var (
 	DnsRequestTotal = promauto.NewCounterVec(prometheus.CounterOpts{
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 )
@@ -152,3 +153,72 @@ func isMountFS(mountInfos []*MountInfo, mntType string, mapRoot string) (bool, b
 func IsMountFS(infos []*MountInfo, mntType string, mapRoot string) (bool, bool) {
 	return isMountFS(infos, mntType, mapRoot)
 }
+
+// GetProcMountInfo returns a slice of *MountInfo with information parsed from
+// <procfs>/<pid>/mountinfo, i.e. the mounts as seen from the mount namespace
+// of the given process.
+func GetProcMountInfo(procfs string, pid uint32) ([]*MountInfo, error) {
+	mountInfoPath := filepath.Join(procfs, strconv.FormatUint(uint64(pid), 10), "mountinfo")
+	fMounts, err := os.Open(mountInfoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open mount information at %s: %s", mountInfoPath, err)
+	}
+	defer fMounts.Close()
+
+	return parseMountInfoFile(fMounts)
+}
+
+// FindMountForPath returns the mount that contains path, that is the mount
+// with the longest mount point that is a prefix of path. When mount points
+// are stacked, the last one wins as it hides the previous ones.
+func FindMountForPath(infos []*MountInfo, path string) *MountInfo {
+	var ret *MountInfo
+
+	for _, mountInfo := range infos {
+		if !hasPathPrefix(path, mountInfo.MountPoint) {
+			continue
+		}
+		if ret == nil || len(mountInfo.MountPoint) >= len(ret.MountPoint) {
+			ret = mountInfo
+		}
+	}
+	return ret
+}
+
+// hasPathPrefix returns true if prefix is a parent directory of path (or
+// path itself).
+func hasPathPrefix(path, prefix string) bool {
+	if prefix == "/" {
+		return strings.HasPrefix(path, "/")
+	}
+	if !strings.HasPrefix(path, prefix) {
+		return false
+	}
+	return len(path) == len(prefix) || path[len(prefix)] == '/'
+}
+
+// TranslatePath translates path from the mount namespace described by
+// fromInfos to the mount namespace described by toInfos. This is done by
+// finding the filesystem path of the mount containing path and then a mount
+// of the same filesystem in the target namespace that exposes it.
+func TranslatePath(fromInfos, toInfos []*MountInfo, path string) (string, bool) {
+	from := FindMountForPath(fromInfos, path)
+	if from == nil {
+		return "", false
+	}
+	fsPath := filepath.Join(from.Root, strings.TrimPrefix(path, from.MountPoint))
+
+	var to *MountInfo
+	for _, mountInfo := range toInfos {
+		if mountInfo.StDev != from.StDev || !hasPathPrefix(fsPath, mountInfo.Root) {
+			continue
+		}
+		if to == nil || len(mountInfo.Root) > len(to.Root) {
+			to = mountInfo
+		}
+	}
+	if to == nil {
+		return "", false
+	}
+	return filepath.Join(to.MountPoint, strings.TrimPrefix(fsPath, to.Root)), true
+}
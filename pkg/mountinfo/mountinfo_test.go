@@ -109,3 +109,34 @@ func mountFS(root, kind string) error {
 	}
 	return nil
 }
+
+func TestTranslatePath(t *testing.T) {
+	// container with an overlayfs root and a bind mounted volume
+	containerInfos := []*MountInfo{
+		{MountID: 1, StDev: "0:50", Root: "/", MountPoint: "/", FilesystemType: "overlay"},
+		{MountID: 2, StDev: "8:1", Root: "/var/lib/volumes/data", MountPoint: "/data", FilesystemType: "ext4"},
+		{MountID: 3, StDev: "0:5", Root: "/", MountPoint: "/proc", FilesystemType: "proc"},
+	}
+	hostInfos := []*MountInfo{
+		{MountID: 10, StDev: "8:1", Root: "/", MountPoint: "/", FilesystemType: "ext4"},
+		{MountID: 11, StDev: "0:50", Root: "/", MountPoint: "/run/containerd/rootfs", FilesystemType: "overlay"},
+	}
+
+	tests := []struct {
+		path     string
+		hostPath string
+		ok       bool
+	}{
+		{"/etc/passwd", "/run/containerd/rootfs/etc/passwd", true},
+		{"/", "/run/containerd/rootfs", true},
+		{"/data/file", "/var/lib/volumes/data/file", true},
+		{"/database/file", "/run/containerd/rootfs/database/file", true},
+		{"/proc/1/status", "", false},
+	}
+	for _, test := range tests {
+		hostPath, ok := TranslatePath(containerInfos, hostInfos, test.path)
+		if ok != test.ok || hostPath != test.hostPath {
+			t.Errorf("TranslatePath(%q) = (%q, %v), want (%q, %v)", test.path, hostPath, ok, test.hostPath, test.ok)
+		}
+	}
+}
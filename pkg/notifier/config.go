@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/isovalent/tetragon-oss/pkg/filters"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	defaultTimeout       = 5 * time.Second
+	defaultRetries       = 3
+	defaultRetryInterval = time.Second
+	defaultQueueSize     = 100
+
+	// maxRetryInterval caps the exponential backoff of the retries.
+	maxRetryInterval = time.Minute
+)
+
+// MatchConfig selects the events that are notified. An event must match all
+// the criteria that are set.
+type MatchConfig struct {
+	// Actions are the actions of kprobe events, such as sigkill or
+	// override.
+	Actions []string `json:"actions,omitempty"`
+	// Policies are the names of the tracing policies of kprobe and
+	// tracepoint events.
+	Policies []string `json:"policies,omitempty"`
+	// AllowList and DenyList filter the events like the ones of
+	// GetEventsRequest.
+	AllowList []*fgs.Filter `json:"allowList,omitempty"`
+	DenyList  []*fgs.Filter `json:"denyList,omitempty"`
+}
+
+// Config configures a webhook, an HTTP endpoint to which the matching events
+// are posted.
+type Config struct {
+	Name string `json:"name"`
+	// URL of the endpoint, http or https.
+	URL string `json:"url"`
+	// Headers are sent with each request, for example for authentication.
+	// The Content-Type defaults to application/json.
+	Headers map[string]string `json:"headers,omitempty"`
+	Match   MatchConfig       `json:"match"`
+	// Template is a text/template of the body. It is executed with the
+	// event as it is exported to JSON, such as
+	// {{.process_kprobe.process.binary}}, and the json function encodes a
+	// value as JSON. The body is the JSON event by default.
+	Template string `json:"template,omitempty"`
+	// Timeout of each request. Defaults to 5s.
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+	// Retries is the number of times a failed request is retried, with an
+	// exponential backoff from RetryInterval, of at most one minute.
+	// Defaults to 3, and -1 disables retries.
+	Retries       int             `json:"retries,omitempty"`
+	RetryInterval metav1.Duration `json:"retryInterval,omitempty"`
+	// RateLimit is the number of notifications sent per minute. Events
+	// over the limit are dropped. It is not rate limited if it is not set
+	// or negative.
+	RateLimit *int `json:"rateLimit,omitempty"`
+	// QueueSize is the number of notifications that wait to be sent.
+	// Events are dropped when the queue is full. Defaults to 100.
+	QueueSize int `json:"queueSize,omitempty"`
+}
+
+// FileConfig is the format of notifier configuration files.
+type FileConfig struct {
+	Webhooks []Config `json:"webhooks"`
+}
+
+// ReadConfigFile reads the webhook configurations of a YAML file, and
+// validates them.
+func ReadConfigFile(fname string) ([]Config, error) {
+	data, err := ioutil.ReadFile(fname)
+	if err != nil {
+		return nil, err
+	}
+	var cfg FileConfig
+	if err := yaml.UnmarshalStrict(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", fname, err)
+	}
+	names := map[string]bool{}
+	for i := range cfg.Webhooks {
+		c := &cfg.Webhooks[i]
+		if c.Name == "" {
+			return nil, fmt.Errorf("webhook %d has no name", i)
+		}
+		if names[c.Name] {
+			return nil, fmt.Errorf("duplicate webhook name %q", c.Name)
+		}
+		names[c.Name] = true
+		if err := c.Validate(); err != nil {
+			return nil, fmt.Errorf("webhook %q: %w", c.Name, err)
+		}
+	}
+	return cfg.Webhooks, nil
+}
+
+// ParseAction parses the action of kprobe events, such as sigkill or
+// KPROBE_ACTION_SIGKILL.
+func ParseAction(action string) (fgs.KprobeAction, error) {
+	name := strings.ToUpper(action)
+	if !strings.HasPrefix(name, "KPROBE_ACTION_") {
+		name = "KPROBE_ACTION_" + name
+	}
+	value, ok := fgs.KprobeAction_value[name]
+	if !ok || value == int32(fgs.KprobeAction_KPROBE_ACTION_UNKNOWN) {
+		return 0, fmt.Errorf("unknown action %q", action)
+	}
+	return fgs.KprobeAction(value), nil
+}
+
+func (c *Config) parseTemplate() (*template.Template, error) {
+	if c.Template == "" {
+		return nil, nil
+	}
+	return template.New(c.Name).Funcs(templateFuncs).Parse(c.Template)
+}
+
+// Validate checks the configuration.
+func (c *Config) Validate() error {
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid url %q, it must be an http or https URL", c.URL)
+	}
+	m := &c.Match
+	if len(m.Actions) == 0 && len(m.Policies) == 0 && len(m.AllowList) == 0 {
+		return errors.New("match requires actions, policies or an allowList")
+	}
+	for _, action := range m.Actions {
+		if _, err := ParseAction(action); err != nil {
+			return err
+		}
+	}
+	if _, err := filters.BuildFilterList(context.Background(), m.AllowList, filters.Filters); err != nil {
+		return fmt.Errorf("invalid allow list: %w", err)
+	}
+	if _, err := filters.BuildFilterList(context.Background(), m.DenyList, filters.Filters); err != nil {
+		return fmt.Errorf("invalid deny list: %w", err)
+	}
+	if _, err := c.parseTemplate(); err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+	if c.Retries < -1 || c.QueueSize < 0 {
+		return errors.New("retries and queue size cannot be negative")
+	}
+	return nil
+}
+
+// Request returns the GetEvents request of the listener of a webhook.
+func (c *Config) Request() *fgs.GetEventsRequest {
+	return &fgs.GetEventsRequest{
+		AllowList: c.Match.AllowList,
+		DenyList:  c.Match.DenyList,
+	}
+}
+
+func durationOr(d metav1.Duration, def time.Duration) time.Duration {
+	if d.Duration <= 0 {
+		return def
+	}
+	return d.Duration
+}
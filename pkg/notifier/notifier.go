@@ -0,0 +1,254 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package notifier posts events to webhooks as soon as they happen, for
+// example when a tracing policy kills a process, so that they do not have to
+// be found in the export files.
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"text/template"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/isovalent/tetragon-oss/pkg/logger"
+	"github.com/isovalent/tetragon-oss/pkg/metrics"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+)
+
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+}
+
+// permanentError is an error that retrying the request does not fix.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Webhook posts the matching events to an HTTP endpoint. It implements the
+// ExportEncoder interface of the exporter package, so that it gets the events
+// of its own GetEvents listener.
+type Webhook struct {
+	name     string
+	url      string
+	headers  map[string]string
+	actions  map[fgs.KprobeAction]bool
+	policies map[string]bool
+	tmpl     *template.Template
+	log      logrus.FieldLogger
+
+	client        *http.Client
+	retries       int
+	retryInterval time.Duration
+	limiter       *rate.Limiter
+	queue         chan *fgs.GetEventsResponse
+}
+
+// New creates a webhook, which stops when ctx is done.
+func New(ctx context.Context, cfg *Config) (*Webhook, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	tmpl, err := cfg.parseTemplate()
+	if err != nil {
+		return nil, err
+	}
+	w := &Webhook{
+		name:          cfg.Name,
+		url:           cfg.URL,
+		headers:       cfg.Headers,
+		actions:       map[fgs.KprobeAction]bool{},
+		policies:      map[string]bool{},
+		tmpl:          tmpl,
+		log:           logger.GetLogger().WithField("webhook", cfg.Name),
+		client:        &http.Client{Timeout: durationOr(cfg.Timeout, defaultTimeout)},
+		retries:       cfg.Retries,
+		retryInterval: durationOr(cfg.RetryInterval, defaultRetryInterval),
+		queue:         make(chan *fgs.GetEventsResponse, defaultQueueSize),
+	}
+	for _, action := range cfg.Match.Actions {
+		a, _ := ParseAction(action)
+		w.actions[a] = true
+	}
+	for _, policy := range cfg.Match.Policies {
+		w.policies[policy] = true
+	}
+	switch {
+	case cfg.Retries == 0:
+		w.retries = defaultRetries
+	case cfg.Retries < 0:
+		w.retries = 0
+	}
+	if cfg.QueueSize > 0 {
+		w.queue = make(chan *fgs.GetEventsResponse, cfg.QueueSize)
+	}
+	if cfg.RateLimit != nil && *cfg.RateLimit >= 0 {
+		limit := rate.Limit(0)
+		if *cfg.RateLimit > 0 {
+			limit = rate.Every(time.Minute / time.Duration(*cfg.RateLimit))
+		}
+		w.limiter = rate.NewLimiter(limit, *cfg.RateLimit)
+	}
+	go w.run(ctx)
+	return w, nil
+}
+
+// matches returns true if an event matches the actions and policies of the
+// webhook. The filters are applied by the listener.
+func (w *Webhook) matches(event *fgs.GetEventsResponse) bool {
+	var policy string
+	action := fgs.KprobeAction_KPROBE_ACTION_UNKNOWN
+	switch ev := event.Event.(type) {
+	case *fgs.GetEventsResponse_ProcessKprobe:
+		policy, action = ev.ProcessKprobe.PolicyName, ev.ProcessKprobe.Action
+	case *fgs.GetEventsResponse_ProcessTracepoint:
+		policy = ev.ProcessTracepoint.PolicyName
+	case *fgs.GetEventsResponse_EventsDropped, *fgs.GetEventsResponse_LostEvents, *fgs.GetEventsResponse_RateLimitInfo:
+		// notifications of the listener are not events
+		return false
+	}
+	if len(w.actions) > 0 && !w.actions[action] {
+		return false
+	}
+	if len(w.policies) > 0 && !w.policies[policy] {
+		return false
+	}
+	return true
+}
+
+// Encode queues the notification of an event if it matches. It does not
+// block, events are dropped when the queue is full.
+func (w *Webhook) Encode(v interface{}) error {
+	event, ok := v.(*fgs.GetEventsResponse)
+	if !ok {
+		return fmt.Errorf("webhook cannot encode %T", v)
+	}
+	if !w.matches(event) {
+		return nil
+	}
+	if w.limiter != nil && !w.limiter.Allow() {
+		metrics.NotifierNotifications.WithLabelValues(w.name, "rate_limited").Inc()
+		return nil
+	}
+	select {
+	case w.queue <- event:
+	default:
+		metrics.NotifierNotifications.WithLabelValues(w.name, "dropped").Inc()
+		w.log.Warn("Dropping notification, the webhook does not keep up")
+	}
+	return nil
+}
+
+// body returns the body of the request of an event.
+func (w *Webhook) body(event *fgs.GetEventsResponse) ([]byte, error) {
+	data, err := event.MarshalJSON()
+	if err != nil || w.tmpl == nil {
+		return data, err
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := w.tmpl.Execute(&buf, fields); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (w *Webhook) run(ctx context.Context) {
+	for {
+		select {
+		case event := <-w.queue:
+			body, err := w.body(event)
+			if err == nil {
+				err = w.send(ctx, body)
+			}
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				metrics.NotifierNotifications.WithLabelValues(w.name, "failed").Inc()
+				w.log.WithError(err).Warn("Failed to send notification")
+				continue
+			}
+			metrics.NotifierNotifications.WithLabelValues(w.name, "sent").Inc()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// send posts a body, and retries with an exponential backoff.
+func (w *Webhook) send(ctx context.Context, body []byte) error {
+	interval := w.retryInterval
+	for attempt := 0; ; attempt++ {
+		err := w.post(ctx, body)
+		var permanent *permanentError
+		if err == nil || errors.As(err, &permanent) || attempt >= w.retries {
+			return err
+		}
+		w.log.WithError(err).Debug("Retrying notification")
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		interval = nextRetryInterval(interval)
+	}
+}
+
+// nextRetryInterval doubles the interval between two attempts, up to
+// maxRetryInterval.
+func nextRetryInterval(interval time.Duration) time.Duration {
+	interval *= 2
+	if interval > maxRetryInterval {
+		return maxRetryInterval
+	}
+	return interval
+}
+
+func (w *Webhook) post(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return &permanentError{err}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range w.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 64*1024))
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	err = fmt.Errorf("webhook %s returned %s", w.url, resp.Status)
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+		return &permanentError{err}
+	}
+	return err
+}
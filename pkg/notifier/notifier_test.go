@@ -0,0 +1,280 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package notifier
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// endpoint is a webhook endpoint. It replies to the first requests with the
+// given status codes, and to the next ones with 200.
+type endpoint struct {
+	mu       sync.Mutex
+	statuses []int
+	attempts int
+	bodies   []string
+	headers  []http.Header
+}
+
+func (e *endpoint) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := ioutil.ReadAll(req.Body)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.attempts++
+	if len(e.statuses) > 0 {
+		status := e.statuses[0]
+		e.statuses = e.statuses[1:]
+		w.WriteHeader(status)
+		return
+	}
+	e.bodies = append(e.bodies, string(body))
+	e.headers = append(e.headers, req.Header)
+}
+
+func (e *endpoint) received() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]string(nil), e.bodies...)
+}
+
+func (e *endpoint) numAttempts() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.attempts
+}
+
+func startEndpoint(t *testing.T, e *endpoint) string {
+	s := httptest.NewServer(e)
+	t.Cleanup(s.Close)
+	return s.URL
+}
+
+func kprobeEvent(action fgs.KprobeAction, policy string) *fgs.GetEventsResponse {
+	return &fgs.GetEventsResponse{
+		Event: &fgs.GetEventsResponse_ProcessKprobe{ProcessKprobe: &fgs.ProcessKprobe{
+			Process: &fgs.Process{
+				Pid:    wrapperspb.UInt32(42),
+				Binary: "/usr/bin/nc",
+			},
+			FunctionName: "__x64_sys_connect",
+			Action:       action,
+			PolicyName:   policy,
+		}},
+		NodeName: "node-1",
+	}
+}
+
+func testConfig(url string) *Config {
+	return &Config{
+		Name:          "test",
+		URL:           url,
+		Match:         MatchConfig{Actions: []string{"sigkill", "KPROBE_ACTION_OVERRIDE"}},
+		RetryInterval: metav1.Duration{Duration: time.Millisecond},
+	}
+}
+
+func TestWebhookMatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	e := &endpoint{}
+	cfg := testConfig(startEndpoint(t, e))
+	cfg.Match.Policies = []string{"block-nc"}
+	cfg.Headers = map[string]string{"Authorization": "Bearer token"}
+	w, err := New(ctx, cfg)
+	require.NoError(t, err)
+
+	require.NoError(t, w.Encode(kprobeEvent(fgs.KprobeAction_KPROBE_ACTION_POST, "block-nc")))
+	require.NoError(t, w.Encode(kprobeEvent(fgs.KprobeAction_KPROBE_ACTION_SIGKILL, "other")))
+	require.NoError(t, w.Encode(&fgs.GetEventsResponse{Event: &fgs.GetEventsResponse_ProcessExec{ProcessExec: &fgs.ProcessExec{}}}))
+	require.NoError(t, w.Encode(kprobeEvent(fgs.KprobeAction_KPROBE_ACTION_SIGKILL, "block-nc")))
+	assert.Error(t, w.Encode("event"))
+
+	assert.Eventually(t, func() bool { return len(e.received()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	var event fgs.GetEventsResponse
+	require.NoError(t, event.UnmarshalJSON([]byte(e.received()[0])))
+	assert.Equal(t, fgs.KprobeAction_KPROBE_ACTION_SIGKILL, event.GetProcessKprobe().Action)
+	assert.Equal(t, "Bearer token", e.headers[0].Get("Authorization"))
+	assert.Equal(t, "application/json", e.headers[0].Get("Content-Type"))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 1, e.numAttempts())
+}
+
+func TestWebhookTemplate(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	e := &endpoint{}
+	cfg := testConfig(startEndpoint(t, e))
+	cfg.Template = `{"text": {{json (printf "%s killed on %s" .process_kprobe.process.binary .node_name)}}}`
+	w, err := New(ctx, cfg)
+	require.NoError(t, err)
+
+	require.NoError(t, w.Encode(kprobeEvent(fgs.KprobeAction_KPROBE_ACTION_SIGKILL, "block-nc")))
+	assert.Eventually(t, func() bool { return len(e.received()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, `{"text": "/usr/bin/nc killed on node-1"}`, e.received()[0])
+}
+
+func TestWebhookRetries(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	e := &endpoint{statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}}
+	w, err := New(ctx, testConfig(startEndpoint(t, e)))
+	require.NoError(t, err)
+	require.NoError(t, w.Encode(kprobeEvent(fgs.KprobeAction_KPROBE_ACTION_SIGKILL, "")))
+	assert.Eventually(t, func() bool { return len(e.received()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 3, e.numAttempts())
+
+	// client errors are not retried
+	e = &endpoint{statuses: []int{http.StatusBadRequest}}
+	w, err = New(ctx, testConfig(startEndpoint(t, e)))
+	require.NoError(t, err)
+	require.NoError(t, w.Encode(kprobeEvent(fgs.KprobeAction_KPROBE_ACTION_SIGKILL, "")))
+	require.NoError(t, w.Encode(kprobeEvent(fgs.KprobeAction_KPROBE_ACTION_OVERRIDE, "")))
+	assert.Eventually(t, func() bool { return len(e.received()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 2, e.numAttempts())
+
+	// the last failure drops the notification
+	e = &endpoint{statuses: []int{500, 500, 500, 500}}
+	cfg := testConfig(startEndpoint(t, e))
+	cfg.Retries = 2
+	w, err = New(ctx, cfg)
+	require.NoError(t, err)
+	require.NoError(t, w.Encode(kprobeEvent(fgs.KprobeAction_KPROBE_ACTION_SIGKILL, "")))
+	require.NoError(t, w.Encode(kprobeEvent(fgs.KprobeAction_KPROBE_ACTION_OVERRIDE, "")))
+	assert.Eventually(t, func() bool { return len(e.received()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 5, e.numAttempts())
+}
+
+func TestWebhookTimeout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	attempts := 0
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		attempts++
+		first := attempts == 1
+		mu.Unlock()
+		if first {
+			time.Sleep(200 * time.Millisecond)
+		}
+	}))
+	t.Cleanup(s.Close)
+	cfg := testConfig(s.URL)
+	cfg.Timeout = metav1.Duration{Duration: 20 * time.Millisecond}
+	w, err := New(ctx, cfg)
+	require.NoError(t, err)
+	require.NoError(t, w.Encode(kprobeEvent(fgs.KprobeAction_KPROBE_ACTION_SIGKILL, "")))
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return attempts == 2
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestWebhookRateLimit(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	e := &endpoint{}
+	cfg := testConfig(startEndpoint(t, e))
+	limit := 2
+	cfg.RateLimit = &limit
+	w, err := New(ctx, cfg)
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, w.Encode(kprobeEvent(fgs.KprobeAction_KPROBE_ACTION_SIGKILL, "")))
+	}
+	assert.Eventually(t, func() bool { return len(e.received()) == 2 }, 5*time.Second, 10*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	assert.Len(t, e.received(), 2)
+}
+
+func TestReadConfigFile(t *testing.T) {
+	fname := filepath.Join(t.TempDir(), "notifiers.yaml")
+	require.NoError(t, ioutil.WriteFile(fname, []byte(`
+webhooks:
+- name: slack
+  url: https://hooks.slack.com/services/T000/B000/XXXX
+  match:
+    actions: [sigkill, override]
+    allowList:
+    - namespace: [production]
+  template: '{"text": {{json .process_kprobe.process.binary}}}'
+  timeout: 2s
+  rateLimit: 10
+- name: siem
+  url: http://siem.example.com/tetragon
+  headers:
+    Authorization: Bearer token
+  match:
+    policies: [sensitive-files]
+`), 0600))
+	configs, err := ReadConfigFile(fname)
+	require.NoError(t, err)
+	require.Len(t, configs, 2)
+	assert.Equal(t, []string{"sigkill", "override"}, configs[0].Match.Actions)
+	assert.Equal(t, []string{"production"}, configs[0].Request().AllowList[0].Namespace)
+	assert.Equal(t, 2*time.Second, configs[0].Timeout.Duration)
+	assert.Equal(t, "Bearer token", configs[1].Headers["Authorization"])
+
+	require.NoError(t, ioutil.WriteFile(fname, []byte(`
+webhooks:
+- name: a
+  url: http://localhost
+  match: {policies: [p]}
+- name: a
+  url: http://localhost
+  match: {policies: [p]}
+`), 0600))
+	_, err = ReadConfigFile(fname)
+	assert.Error(t, err)
+}
+
+func TestConfigValidation(t *testing.T) {
+	match := MatchConfig{Actions: []string{"sigkill"}}
+	tests := []struct {
+		name   string
+		config Config
+	}{
+		{"no url", Config{Match: match}},
+		{"invalid url scheme", Config{URL: "ftp://localhost", Match: match}},
+		{"empty match", Config{URL: "http://localhost"}},
+		{"unknown action", Config{URL: "http://localhost", Match: MatchConfig{Actions: []string{"block"}}}},
+		{"invalid template", Config{URL: "http://localhost", Match: match, Template: "{{.process"}},
+		{"negative retries", Config{URL: "http://localhost", Match: match, Retries: -2}},
+		{"invalid allow list", Config{URL: "http://localhost", Match: MatchConfig{AllowList: []*fgs.Filter{{BinaryRegex: []string{"["}}}}}},
+		{"invalid deny list", Config{URL: "http://localhost", Match: MatchConfig{
+			Actions:  []string{"sigkill"},
+			DenyList: []*fgs.Filter{{EventSet: []fgs.EventType{fgs.EventType(1000)}}},
+		}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, tt.config.Validate())
+		})
+	}
+}
+
+func TestNextRetryInterval(t *testing.T) {
+	assert.Equal(t, 2*time.Second, nextRetryInterval(time.Second))
+	assert.Equal(t, maxRetryInterval, nextRetryInterval(45*time.Second))
+	assert.Equal(t, maxRetryInterval, nextRetryInterval(time.Hour))
+}
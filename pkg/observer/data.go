@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package observer
+
+import (
+	"bytes"
+	"container/list"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/isovalent/tetragon-oss/pkg/api/dataapi"
+	"github.com/isovalent/tetragon-oss/pkg/api/ops"
+	"github.com/isovalent/tetragon-oss/pkg/logger"
+)
+
+const (
+	// dataCacheMaxBytes bounds the memory used by data events that have not
+	// been claimed yet. Data events are sent before the event referencing
+	// them, but the referencing event might never arrive (e.g., it was
+	// filtered or lost), so oldest entries are evicted once the limit is
+	// reached.
+	dataCacheMaxBytes = 16 * 1024 * 1024
+)
+
+type dataEntry struct {
+	id   dataapi.DataEventId
+	data []byte
+}
+
+type dataCache struct {
+	mu      sync.Mutex
+	entries map[dataapi.DataEventId]*list.Element
+	lru     *list.List
+	size    int
+	maxSize int
+}
+
+var dataEvents = newDataCache(dataCacheMaxBytes)
+
+func init() {
+	RegisterEventHandlerAtInit(ops.MSG_OP_DATA, handleData)
+}
+
+func newDataCache(maxSize int) *dataCache {
+	return &dataCache{
+		entries: make(map[dataapi.DataEventId]*list.Element),
+		lru:     list.New(),
+		maxSize: maxSize,
+	}
+}
+
+func (c *dataCache) remove(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*dataEntry)
+	delete(c.entries, entry.id)
+	c.size -= len(entry.data)
+}
+
+// add appends data to the entry of id, creating it if needed.
+func (c *dataCache) add(id dataapi.DataEventId, data []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[id]; ok {
+		entry := elem.Value.(*dataEntry)
+		entry.data = append(entry.data, data...)
+	} else {
+		c.entries[id] = c.lru.PushBack(&dataEntry{id: id, data: data})
+	}
+	c.size += len(data)
+
+	for c.size > c.maxSize {
+		oldest := c.lru.Front()
+		if oldest == nil {
+			break
+		}
+		logger.GetLogger().WithField("id", oldest.Value.(*dataEntry).id).Debug("Data event evicted")
+		c.remove(oldest)
+	}
+}
+
+// get returns and removes the data of the entry described by desc.
+func (c *dataCache) get(desc *dataapi.DataEventDesc) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[desc.Id]
+	if !ok {
+		return nil, fmt.Errorf("data event with id %v not found", desc.Id)
+	}
+	c.remove(elem)
+
+	data := elem.Value.(*dataEntry).data
+	if len(data) != int(desc.Size) {
+		return nil, fmt.Errorf("data event with id %v has wrong size: got %d, expected %d",
+			desc.Id, len(data), desc.Size)
+	}
+	return data, nil
+}
+
+// DataGet returns the payload described by desc. The payload is removed from
+// the cache, so it can only be retrieved once.
+func DataGet(desc *dataapi.DataEventDesc) ([]byte, error) {
+	if desc.Size == 0 {
+		return nil, nil
+	}
+	return dataEvents.get(desc)
+}
+
+func handleData(r *bytes.Reader) ([]Event, error) {
+	m := dataapi.MsgData{}
+	err := binary.Read(r, binary.LittleEndian, &m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read data msg: %w", err)
+	}
+
+	size := int(m.Common.Size) - binary.Size(m)
+	if size < 0 || size > dataapi.MsgDataArgLen {
+		return nil, fmt.Errorf("data msg has invalid size %d", m.Common.Size)
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, fmt.Errorf("failed to read data msg payload (size: %d): %w", size, err)
+	}
+
+	dataEvents.add(m.Id, data)
+	return nil, nil
+}
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package observer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/isovalent/tetragon-oss/pkg/api/dataapi"
+	"github.com/isovalent/tetragon-oss/pkg/api/processapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func dataDesc(id dataapi.DataEventId, size int) *dataapi.DataEventDesc {
+	return &dataapi.DataEventDesc{Id: id, Size: uint32(size)}
+}
+
+// dataMsg returns a data event as it is read from the perf ring.
+func dataMsg(t *testing.T, id dataapi.DataEventId, payload []byte) *bytes.Reader {
+	m := dataapi.MsgData{Id: id}
+	m.Common.Size = uint32(binary.Size(m) + len(payload))
+	var buf bytes.Buffer
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, &m))
+	buf.Write(payload)
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestDataCacheChunks(t *testing.T) {
+	c := newDataCache(1024)
+	id := dataapi.DataEventId{Pid: 1, Time: 1}
+	c.add(id, []byte("hello "))
+	c.add(id, []byte("world"))
+	assert.Equal(t, 11, c.size)
+
+	data, err := c.get(dataDesc(id, 11))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello world"), data)
+	assert.Equal(t, 0, c.size)
+
+	// entries can only be claimed once
+	_, err = c.get(dataDesc(id, 11))
+	assert.Error(t, err)
+}
+
+func TestDataCacheWrongSize(t *testing.T) {
+	c := newDataCache(1024)
+	id := dataapi.DataEventId{Pid: 1, Time: 1}
+	c.add(id, []byte("hello"))
+	_, err := c.get(dataDesc(id, 10))
+	assert.Error(t, err)
+	assert.Empty(t, c.entries)
+}
+
+func TestDataCacheMissing(t *testing.T) {
+	c := newDataCache(1024)
+	_, err := c.get(dataDesc(dataapi.DataEventId{Pid: 1, Time: 1}, 5))
+	assert.Error(t, err)
+}
+
+func TestDataCacheEviction(t *testing.T) {
+	c := newDataCache(10)
+	first := dataapi.DataEventId{Pid: 1, Time: 1}
+	second := dataapi.DataEventId{Pid: 2, Time: 2}
+	third := dataapi.DataEventId{Pid: 3, Time: 3}
+	c.add(first, []byte("aaaa"))
+	c.add(second, []byte("bbbb"))
+	c.add(third, []byte("cccc"))
+
+	// the oldest entry is evicted to stay within the limit
+	assert.Equal(t, 8, c.size)
+	_, err := c.get(dataDesc(first, 4))
+	assert.Error(t, err)
+	data, err := c.get(dataDesc(second, 4))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("bbbb"), data)
+	data, err = c.get(dataDesc(third, 4))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("cccc"), data)
+}
+
+func TestDataGetEmpty(t *testing.T) {
+	data, err := DataGet(&dataapi.DataEventDesc{})
+	assert.NoError(t, err)
+	assert.Nil(t, data)
+}
+
+func TestHandleData(t *testing.T) {
+	id := dataapi.DataEventId{Pid: 42, Time: 1000}
+	for _, chunk := range []string{"first ", "second"} {
+		events, err := handleData(dataMsg(t, id, []byte(chunk)))
+		require.NoError(t, err)
+		assert.Nil(t, events)
+	}
+	data, err := DataGet(dataDesc(id, 12))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("first second"), data)
+}
+
+func TestHandleDataInvalid(t *testing.T) {
+	id := dataapi.DataEventId{Pid: 42, Time: 2000}
+
+	// truncated header
+	_, err := handleData(bytes.NewReader([]byte{1, 2, 3}))
+	assert.Error(t, err)
+
+	// size smaller than the header
+	m := dataapi.MsgData{Common: processapi.MsgCommon{Size: 1}, Id: id}
+	var buf bytes.Buffer
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, &m))
+	_, err = handleData(bytes.NewReader(buf.Bytes()))
+	assert.Error(t, err)
+
+	// size larger than the maximum payload
+	m.Common.Size = uint32(binary.Size(m) + dataapi.MsgDataArgLen + 1)
+	buf.Reset()
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, &m))
+	_, err = handleData(bytes.NewReader(buf.Bytes()))
+	assert.Error(t, err)
+
+	// payload shorter than its size
+	r := dataMsg(t, id, []byte("payload"))
+	truncated := make([]byte, r.Len()-1)
+	_, _ = r.Read(truncated)
+	_, err = handleData(bytes.NewReader(truncated))
+	assert.Error(t, err)
+
+	_, err = DataGet(dataDesc(id, 7))
+	assert.Error(t, err)
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package observer
+
+import (
+	"sort"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/pkg/api/lostapi"
+)
+
+// lostEventsInterval is the interval over which the events lost in the perf
+// ring are combined before the listeners are notified.
+const lostEventsInterval = time.Second
+
+type lostKey struct {
+	cpu    int
+	sensor string
+}
+
+// lostEvents combines the counts of lost events per CPU and sensor, so that
+// listeners get at most one notification per CPU, sensor and interval instead
+// of one per lost record, which would add traffic when the agent is already
+// overloaded. It is only used by the goroutine that reads the perf ring.
+type lostEvents struct {
+	counts map[lostKey]uint64
+	// since is the time of the oldest pending count
+	since time.Time
+}
+
+func (l *lostEvents) add(cpu int, sensor string, count uint64, now time.Time) {
+	if l.counts == nil {
+		l.counts = make(map[lostKey]uint64)
+	}
+	if len(l.counts) == 0 {
+		l.since = now
+	}
+	l.counts[lostKey{cpu: cpu, sensor: sensor}] += count
+}
+
+// take returns the pending counts, in CPU and sensor order, once the oldest
+// one is older than lostEventsInterval, and resets them.
+func (l *lostEvents) take(now time.Time) []*lostapi.MsgLostEvents {
+	if len(l.counts) == 0 || now.Sub(l.since) < lostEventsInterval {
+		return nil
+	}
+	ret := make([]*lostapi.MsgLostEvents, 0, len(l.counts))
+	for key, count := range l.counts {
+		ret = append(ret, &lostapi.MsgLostEvents{Cpu: key.cpu, Sensor: key.sensor, Count: count})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Cpu != ret[j].Cpu {
+			return ret[i].Cpu < ret[j].Cpu
+		}
+		return ret[i].Sensor < ret[j].Sensor
+	})
+	l.counts = make(map[lostKey]uint64)
+	return ret
+}
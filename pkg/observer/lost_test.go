@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package observer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/pkg/api/lostapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLostEvents(t *testing.T) {
+	var l lostEvents
+	now := time.Now()
+	assert.Nil(t, l.take(now))
+
+	l.add(1, "", 3, now)
+	l.add(0, "", 1, now.Add(100*time.Millisecond))
+	l.add(1, "", 2, now.Add(200*time.Millisecond))
+	l.add(1, "GenericKprobe", 1, now.Add(300*time.Millisecond))
+	// counts are combined until the interval elapsed
+	assert.Nil(t, l.take(now.Add(500*time.Millisecond)))
+	assert.Equal(t, []*lostapi.MsgLostEvents{
+		{Cpu: 0, Count: 1},
+		{Cpu: 1, Count: 5},
+		{Cpu: 1, Sensor: "GenericKprobe", Count: 1},
+	}, l.take(now.Add(lostEventsInterval)))
+	assert.Nil(t, l.take(now.Add(2*lostEventsInterval)))
+
+	// the interval starts again with the next count
+	later := now.Add(10 * lostEventsInterval)
+	l.add(2, "", 7, later)
+	assert.Nil(t, l.take(later))
+	assert.Equal(t, []*lostapi.MsgLostEvents{{Cpu: 2, Count: 7}}, l.take(later.Add(lostEventsInterval)))
+}
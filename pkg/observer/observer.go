@@ -19,6 +19,7 @@ import (
 	"github.com/isovalent/tetragon-oss/pkg/bpf"
 	"github.com/isovalent/tetragon-oss/pkg/logger"
 	"github.com/isovalent/tetragon-oss/pkg/metrics"
+	"github.com/isovalent/tetragon-oss/pkg/option"
 	"github.com/isovalent/tetragon-oss/pkg/sensors"
 
 	"github.com/sirupsen/logrus"
@@ -33,6 +34,7 @@ const (
 	// which relies on frame ordering (it does limited reordering)
 	maxEventsPerRing = 4
 
+	// Default size of the per-CPU perf ring, see option.Config.PerfRingBufferSize.
 	perCPUBufferBytes = 65535
 
 	// Use cilium/ebpf to read events from the perf ring. Since we're
@@ -97,10 +99,16 @@ func (k *Observer) receiveEvent(data []byte, cpu int) {
 
 	// These ops handlers are registered by RegisterEventHandlerAtInit().
 	if h, ok := eventHandler[op]; ok {
-		if events, err := h(r); err == nil {
-			for _, event := range events {
-				k.observerListeners(event)
-			}
+		events, err := h(r)
+		if err != nil {
+			// The event was received but cannot be sent to the
+			// listeners, so it is lost for them.
+			k.log.WithError(err).Debugf("failed to handle %s event", ops.OpCode(op))
+			k.notifyLost(cpu, ops.OpCode(op).String(), 1)
+			return
+		}
+		for _, event := range events {
+			k.observerListeners(event)
 		}
 	} else {
 		k.log.Infof("unknown op ignored: %v", op)
@@ -115,8 +123,25 @@ func (k *Observer) __runEvents(stopCtx context.Context) (*bpf.PerCpuEvents, erro
 	return e, nil
 }
 
+// notifyLost records that events were lost, so that the listeners can report
+// that their view is incomplete. See flushLost.
+// The sensor is empty when it is not known.
+func (k *Observer) notifyLost(cpu int, sensor string, count uint64) {
+	k.lost.add(cpu, sensor, count, time.Now())
+}
+
+// flushLost notifies the listeners of the events lost over the last
+// lostEventsInterval. It is called by the perf ring reader after reading
+// events.
+func (k *Observer) flushLost() {
+	for _, msg := range k.lost.take(time.Now()) {
+		k.observerListeners(msg)
+	}
+}
+
 func (k *Observer) observerLost(msg *bpf.PerfEventLost, cpu int) {
 	k.lostCntr++
+	k.notifyLost(cpu, "", msg.Lost)
 }
 
 func (k *Observer) observerError(msg *bpf.PerfEvent) {
@@ -159,6 +184,7 @@ func (k *Observer) __loopEvents(stopCtx context.Context, e *bpf.PerCpuEvents) er
 		if err := e.ReadAll(maxEventsPerRing, receiveEvent, observerLost, observerError); err != nil {
 			k.log.WithError(err).Warn("kprobe events read failed")
 		}
+		k.flushLost()
 
 		metrics.RingBufPerfEventReceived.WithLabelValues().Set(float64(k.recvCntr))
 		metrics.RingBufPerfEventLost.WithLabelValues().Set(float64(k.lostCntr))
@@ -186,7 +212,11 @@ func (k *Observer) runEventsNew(stopCtx context.Context, ready func()) error {
 	}
 	defer perfMap.Close()
 
-	perfReader, err := perf.NewReader(perfMap, perCPUBufferBytes)
+	bufferBytes := option.Config.PerfRingBufferSize
+	if bufferBytes <= 0 {
+		bufferBytes = perCPUBufferBytes
+	}
+	perfReader, err := perf.NewReader(perfMap, bufferBytes)
 	if err != nil {
 		return fmt.Errorf("creating perf array reader failed: %w", err)
 	}
@@ -223,7 +253,9 @@ func (k *Observer) runEventsNew(stopCtx context.Context, ready func()) error {
 				if record.LostSamples > 0 {
 					k.lostCntr += int(record.LostSamples)
 					metrics.RingBufPerfEventLost.WithLabelValues().Set(float64(k.lostCntr))
+					k.notifyLost(record.CPU, "", record.LostSamples)
 				}
+				k.flushLost()
 			}
 		}
 	}()
@@ -246,6 +278,7 @@ type Observer struct {
 	perfConfig *bpf.PerfEventConfig
 	/* Statistics */
 	lostCntr   int
+	lost       lostEvents
 	errorCntr  int
 	recvCntr   int
 	filterPass int
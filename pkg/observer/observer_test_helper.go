@@ -343,7 +343,7 @@ func loadExporter(t *testing.T, obs *Observer, opts *testExporterOptions, oo *te
 	}
 	denyList, _ := filters.ParseFilterList("")
 	req := fgs.GetEventsRequest{AllowList: allowList, DenyList: denyList}
-	exporter := exporter.NewExporter(context.Background(), &req, processManager.Server, encoder, nil)
+	exporter := exporter.NewExporter(context.Background(), "test", &req, processManager.Server, encoder, nil)
 	logger.GetLogger().Info("Starting JSON exporter")
 	exporter.Start()
 	obs.AddListener(processManager)
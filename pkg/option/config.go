@@ -3,6 +3,8 @@
 
 package option
 
+import "time"
+
 // Config contains all the configuration used by FGS.
 var Config = config{
 	// Initialize global defaults below.
@@ -24,5 +26,20 @@ type config struct {
 	IgnoreMissingProgs bool
 	ForceSmallProgs    bool
 
+	EnablePathResolution bool
+
+	// EventHistorySize is the number of recent events kept in memory to be
+	// replayed by GetEvents requests. Zero disables the event history.
+	EventHistorySize int
+	// EventHistoryMaxAge is the maximum age of the events kept in the event
+	// history. Zero means that events only expire when the history is full.
+	EventHistoryMaxAge time.Duration
+
+	// PerfRingBufferSize is the size in bytes of the per-CPU perf ring
+	// that events are read from. Policies capturing arguments with a large
+	// maxData value need a ring that holds the whole argument, sent as data
+	// events, plus the event referencing it.
+	PerfRingBufferSize int
+
 	LogOpts map[string]string
 }
@@ -5,20 +5,27 @@ package ratelimit
 
 import (
 	"context"
-	"encoding/json"
 	"sync/atomic"
 	"time"
 
-	"github.com/isovalent/tetragon-oss/pkg/logger"
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
 	"github.com/isovalent/tetragon-oss/pkg/reader/node"
 	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// Notifier publishes events to the GetEvents listeners of a name.
+type Notifier interface {
+	NotifyNamedListeners(name string, processed *fgs.GetEventsResponse)
+}
+
 type RateLimiter struct {
 	*rate.Limiter
 	ctx            context.Context
 	reportInterval time.Duration
 	dropped        uint64 // accessed atomically
+	name           string
+	listener       string
 }
 
 // getLimit converts an numEvents and interval to rate.Limit which is a floating point value
@@ -30,7 +37,12 @@ func getLimit(numEvents int, interval time.Duration) rate.Limit {
 	return rate.Every(interval / time.Duration(numEvents))
 }
 
-func NewRateLimiter(ctx context.Context, interval time.Duration, numEvents int, encoder *json.Encoder) *RateLimiter {
+// NewRateLimiter returns a rate limiter that allows numEvents per interval.
+// The number of dropped events is published every interval as a RateLimitInfo
+// event, with the name of the exporter that is rate limited. notifier sends it
+// to the GetEvents listener of the exporter only, so that other clients do not
+// get it.
+func NewRateLimiter(ctx context.Context, interval time.Duration, numEvents int, name, listener string, notifier Notifier) *RateLimiter {
 	if numEvents < 0 {
 		return nil
 	}
@@ -39,39 +51,35 @@ func NewRateLimiter(ctx context.Context, interval time.Duration, numEvents int,
 		ctx,
 		interval, // TODO(tk): use a separate interval for reporting?
 		0,
+		name,
+		listener,
 	}
-	go r.reportRateLimitInfo(encoder)
+	go r.reportRateLimitInfo(notifier)
 	return r
 }
 
-type Info struct {
-	NumberOfDroppedProcessEvents uint64 `json:"number_of_dropped_process_events"`
-}
-
-type InfoEvent struct {
-	RateLimitInfo *Info     `json:"rate_limit_info"`
-	NodeName      string    `json:"node_name"`
-	Time          time.Time `json:"time"`
+func (r *RateLimiter) rateLimitInfoResponse(dropped uint64) *fgs.GetEventsResponse {
+	return &fgs.GetEventsResponse{
+		Event: &fgs.GetEventsResponse_RateLimitInfo{
+			RateLimitInfo: &fgs.RateLimitInfo{
+				NumberOfDroppedProcessEvents: dropped,
+				Exporter:                     r.name,
+			},
+		},
+		NodeName: node.GetNodeNameForExport(),
+		Time:     timestamppb.Now(),
+	}
 }
 
-func (r *RateLimiter) reportRateLimitInfo(encoder *json.Encoder) {
+func (r *RateLimiter) reportRateLimitInfo(notifier Notifier) {
 	ticker := time.NewTicker(r.reportInterval)
+	defer ticker.Stop()
 	for {
 		select {
 		case <-ticker.C:
 			dropped := atomic.SwapUint64(&r.dropped, 0)
 			if dropped > 0 {
-				err := encoder.Encode(&InfoEvent{
-					RateLimitInfo: &Info{NumberOfDroppedProcessEvents: dropped},
-					NodeName:      node.GetNodeNameForExport(),
-					Time:          time.Now(),
-				})
-				if err != nil {
-					logger.GetLogger().
-						WithError(err).
-						WithField("dropped", dropped).
-						Warn("Failed to encode rate_limit_info event")
-				}
+				notifier.NotifyNamedListeners(r.listener, r.rateLimitInfoResponse(dropped))
 			}
 		case <-r.ctx.Done():
 			return
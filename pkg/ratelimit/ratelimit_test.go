@@ -4,10 +4,11 @@
 package ratelimit
 
 import (
-	"encoding/json"
+	"context"
 	"testing"
 	"time"
 
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/time/rate"
 )
@@ -27,13 +28,31 @@ func Test_getLimit(t *testing.T) {
 	assert.Equal(t, rate.Inf, getLimit(1, -1))
 }
 
-func Test_rateLimitJSON(t *testing.T) {
-	ev := InfoEvent{
-		RateLimitInfo: &Info{NumberOfDroppedProcessEvents: 10},
-		NodeName:      "my-node",
-		Time:          time.Time{},
+type fakeNotifier struct {
+	events chan *fgs.GetEventsResponse
+}
+
+func (f *fakeNotifier) NotifyNamedListeners(name string, processed *fgs.GetEventsResponse) {
+	if name == "exporter/test" {
+		f.events <- processed
+	}
+}
+
+func Test_reportRateLimitInfo(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	notifier := &fakeNotifier{events: make(chan *fgs.GetEventsResponse, 1)}
+	r := NewRateLimiter(ctx, 10*time.Millisecond, 1, "test", "exporter/test", notifier)
+	for i := 0; i < 3; i++ {
+		r.Drop()
+	}
+
+	select {
+	case res := <-notifier.events:
+		assert.Equal(t, uint64(3), res.GetRateLimitInfo().GetNumberOfDroppedProcessEvents())
+		assert.Equal(t, "test", res.GetRateLimitInfo().GetExporter())
+		assert.NotNil(t, res.Time)
+	case <-time.After(time.Second):
+		t.Fatal("no rate_limit_info event was reported")
 	}
-	b, err := json.Marshal(ev)
-	assert.NoError(t, err)
-	assert.Equal(t, `{"rate_limit_info":{"number_of_dropped_process_events":10},"node_name":"my-node","time":"0001-01-01T00:00:00Z"}`, string(b))
 }
@@ -0,0 +1,242 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+package path
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/pkg/api/processapi"
+	"github.com/isovalent/tetragon-oss/pkg/mountinfo"
+)
+
+const (
+	// mountsCacheTTL is how long the mounts of a mount namespace are
+	// cached before being read again from procfs.
+	mountsCacheTTL = 5 * time.Second
+	// mountsCacheMaxEntries bounds the number of cached mount namespaces.
+	mountsCacheMaxEntries = 1024
+	// completedCacheMaxEntries bounds the number of completed paths cached
+	// for each mount namespace.
+	completedCacheMaxEntries = 4096
+)
+
+// mountsEntry caches the mounts of a mount namespace, and the paths that
+// were completed with them. Entries are loaded and refreshed in the
+// background, so that resolving paths does not read procfs for each event.
+type mountsEntry struct {
+	infos   []*mountinfo.MountInfo
+	updated time.Time
+	loading bool
+	// completed maps paths built in BPF to the paths completed by
+	// completeMountPoints, reset when the mounts are refreshed.
+	completed map[string]string
+}
+
+// Resolver completes paths that could not be fully resolved in BPF (see
+// UnresolvedMountPoints and UnresolvedPathComponents) using the procfs view
+// of the process, and translates paths from the mount namespace of a process
+// to the host mount namespace.
+type Resolver struct {
+	procFS string
+
+	mu        sync.Mutex
+	mounts    map[uint32]*mountsEntry
+	host      *mountsEntry
+	hostMntns uint32
+	// loads tracks the background loads of mounts
+	loads sync.WaitGroup
+}
+
+// Resolved is the result of a path resolution.
+type Resolved struct {
+	// Path is the path in the mount namespace of the process. It is empty
+	// if the path could not be completed.
+	Path string
+	// HostPath is the path in the host mount namespace. It is empty if the
+	// path could not be translated.
+	HostPath string
+}
+
+func NewResolver(procFS string) *Resolver {
+	return &Resolver{
+		procFS: procFS,
+		mounts: make(map[uint32]*mountsEntry),
+	}
+}
+
+// mntnsInode returns the inode of the mount namespace of pid, or 0.
+func mntnsInode(procFS string, pid uint32) uint32 {
+	link, err := os.Readlink(filepath.Join(procFS, strconv.FormatUint(uint64(pid), 10), "ns", "mnt"))
+	if err != nil {
+		return 0
+	}
+	var inode uint32
+	if _, err := fmt.Sscanf(link, "mnt:[%d]", &inode); err != nil {
+		return 0
+	}
+	return inode
+}
+
+// load reads the mounts of pid into e. If they cannot be read, the previous
+// mounts are kept until the next refresh, so that failures are not retried
+// for each event.
+func (r *Resolver) load(e *mountsEntry, pid uint32, host bool) {
+	defer r.loads.Done()
+
+	infos, err := mountinfo.GetProcMountInfo(r.procFS, pid)
+	var hostMntns uint32
+	if host {
+		hostMntns = mntnsInode(r.procFS, pid)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err == nil {
+		e.infos = infos
+		e.completed = make(map[string]string)
+	}
+	if hostMntns != 0 {
+		r.hostMntns = hostMntns
+	}
+	e.updated = time.Now()
+	e.loading = false
+}
+
+// refresh starts loading e in the background if it is stale. r.mu must be
+// held.
+func (r *Resolver) refresh(e *mountsEntry, pid uint32, host bool) {
+	if e.loading || time.Since(e.updated) < mountsCacheTTL {
+		return
+	}
+	e.loading = true
+	r.loads.Add(1)
+	go r.load(e, pid, host)
+}
+
+// getMounts returns the cached mounts of mntns, or nil if they are not known
+// yet. r.mu must be held.
+func (r *Resolver) getMounts(pid uint32, mntns uint32) *mountsEntry {
+	e, ok := r.mounts[mntns]
+	if !ok {
+		if len(r.mounts) >= mountsCacheMaxEntries {
+			r.mounts = make(map[uint32]*mountsEntry)
+		}
+		e = &mountsEntry{}
+		r.mounts[mntns] = e
+	}
+	r.refresh(e, pid, false)
+	return e
+}
+
+// getHostMounts returns the cached mounts of the host mount namespace. r.mu
+// must be held.
+func (r *Resolver) getHostMounts() *mountsEntry {
+	if r.host == nil {
+		r.host = &mountsEntry{}
+	}
+	// pid 1 is in the host mount namespace, even if the agent is not
+	r.refresh(r.host, 1, true)
+	return r.host
+}
+
+// procPath returns the path of p as seen from the root of the process.
+func (r *Resolver) procPath(pid uint32, p string) string {
+	return filepath.Join(r.procFS, strconv.FormatUint(uint64(pid), 10), "root", p)
+}
+
+// completeMountPoints tries to find the mount point that was not resolved in
+// BPF. Every mount point of the process is tried as a prefix and the result
+// is used only if a single candidate exists.
+func (r *Resolver) completeMountPoints(pid uint32, infos []*mountinfo.MountInfo, p string) string {
+	var found string
+
+	for _, mountInfo := range infos {
+		if mountInfo.MountPoint == "/" {
+			continue
+		}
+		candidate := filepath.Join(mountInfo.MountPoint, p)
+		if _, err := os.Lstat(r.procPath(pid, candidate)); err != nil {
+			continue
+		}
+		if found != "" && found != candidate {
+			return ""
+		}
+		found = candidate
+	}
+	return found
+}
+
+// Resolve resolves path p of process pid, where p is the path built in BPF
+// (after GenPath) and flags are the BPF path flags. If fd is not negative,
+// it is the file descriptor that refers to the path in the process, and it
+// is used to complete truncated paths.
+//
+// The mounts of mntns are read in the background the first time it is seen,
+// and its paths are neither completed nor translated until they are known.
+func (r *Resolver) Resolve(pid uint32, mntns uint32, fd int32, p string, flags uint32) Resolved {
+	var ret Resolved
+
+	r.mu.Lock()
+	e := r.getMounts(pid, mntns)
+	infos := e.infos
+	host := r.getHostMounts()
+	hostInfos, hostMntns := host.infos, r.hostMntns
+	r.mu.Unlock()
+
+	unresolved := flags & (processapi.UnresolvedMountPoints | processapi.UnresolvedPathComponents)
+	switch {
+	case unresolved == 0:
+		ret.Path = p
+	case fd >= 0:
+		link := filepath.Join(r.procFS, strconv.FormatUint(uint64(pid), 10), "fd", strconv.FormatInt(int64(fd), 10))
+		if target, err := os.Readlink(link); err == nil && strings.HasPrefix(target, "/") {
+			ret.Path = target
+		}
+	case unresolved == processapi.UnresolvedMountPoints && infos != nil:
+		ret.Path = r.completePath(e, pid, infos, p)
+	}
+
+	if ret.Path == "" {
+		return ret
+	}
+	if mntns != 0 && mntns == hostMntns {
+		ret.HostPath = ret.Path
+		return ret
+	}
+	if infos == nil || hostInfos == nil {
+		return ret
+	}
+	if hostPath, ok := mountinfo.TranslatePath(infos, hostInfos, ret.Path); ok {
+		ret.HostPath = hostPath
+	}
+	return ret
+}
+
+// completePath returns the completed path of p, using the paths cached in e
+// to avoid looking up the mount points for each event.
+func (r *Resolver) completePath(e *mountsEntry, pid uint32, infos []*mountinfo.MountInfo, p string) string {
+	r.mu.Lock()
+	completed, ok := e.completed[p]
+	r.mu.Unlock()
+	if ok {
+		return completed
+	}
+
+	completed = r.completeMountPoints(pid, infos, p)
+
+	r.mu.Lock()
+	if e.completed != nil {
+		if len(e.completed) >= completedCacheMaxEntries {
+			e.completed = make(map[string]string)
+		}
+		e.completed[p] = completed
+	}
+	r.mu.Unlock()
+	return completed
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+package path
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/isovalent/tetragon-oss/pkg/api/processapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testHostMntns      = 100
+	testContainerMntns = 200
+	testContainerPid   = 42
+)
+
+// testProcFS returns a procfs with the host (pid 1) and a container process
+// whose /data mount is also mounted on /var/lib/data in the host.
+func testProcFS(t *testing.T) string {
+	procFS := t.TempDir()
+	write := func(name, content string) {
+		p := filepath.Join(procFS, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0644))
+	}
+	write("1/mountinfo", "1 0 8:1 / / rw - ext4 /dev/sda1 rw\n"+
+		"2 1 8:2 / /var/lib/data rw - ext4 /dev/sda2 rw\n")
+	require.NoError(t, os.MkdirAll(filepath.Join(procFS, "1/ns"), 0755))
+	require.NoError(t, os.Symlink("mnt:[100]", filepath.Join(procFS, "1/ns/mnt")))
+	write("42/mountinfo", "10 9 0:50 / / rw - overlay overlay rw\n"+
+		"11 10 8:2 /vol /data rw - ext4 /dev/sda2 rw\n")
+	write("42/root/data/file.txt", "")
+	return procFS
+}
+
+func TestResolverCompleteMountPoints(t *testing.T) {
+	procFS := testProcFS(t)
+	r := NewResolver(procFS)
+
+	// mounts are loaded in the background the first time a mount
+	// namespace is seen
+	res := r.Resolve(testContainerPid, testContainerMntns, -1, "/file.txt", processapi.UnresolvedMountPoints)
+	assert.Equal(t, Resolved{}, res)
+	r.loads.Wait()
+
+	res = r.Resolve(testContainerPid, testContainerMntns, -1, "/file.txt", processapi.UnresolvedMountPoints)
+	assert.Equal(t, Resolved{Path: "/data/file.txt", HostPath: "/var/lib/data/vol/file.txt"}, res)
+
+	// completed paths are cached, procfs is not looked up again
+	require.NoError(t, os.Remove(filepath.Join(procFS, "42/root/data/file.txt")))
+	res = r.Resolve(testContainerPid, testContainerMntns, -1, "/file.txt", processapi.UnresolvedMountPoints)
+	assert.Equal(t, "/data/file.txt", res.Path)
+
+	// ambiguous or missing paths are not completed
+	res = r.Resolve(testContainerPid, testContainerMntns, -1, "/missing", processapi.UnresolvedMountPoints)
+	assert.Equal(t, Resolved{}, res)
+}
+
+func TestResolverHostPath(t *testing.T) {
+	r := NewResolver(testProcFS(t))
+	r.Resolve(testContainerPid, testContainerMntns, -1, "/", 0)
+	r.loads.Wait()
+
+	// the root of the container is not mounted in the host
+	res := r.Resolve(testContainerPid, testContainerMntns, -1, "/etc/passwd", 0)
+	assert.Equal(t, Resolved{Path: "/etc/passwd"}, res)
+
+	res = r.Resolve(testContainerPid, testContainerMntns, -1, "/data/a/b", 0)
+	assert.Equal(t, Resolved{Path: "/data/a/b", HostPath: "/var/lib/data/vol/a/b"}, res)
+
+	// paths of processes in the host mount namespace are not translated
+	res = r.Resolve(1, testHostMntns, -1, "/etc/passwd", 0)
+	assert.Equal(t, Resolved{Path: "/etc/passwd", HostPath: "/etc/passwd"}, res)
+}
+
+func TestResolverCacheFailures(t *testing.T) {
+	r := NewResolver(testProcFS(t))
+
+	// the process exited, its mounts are unknown until the next refresh
+	res := r.Resolve(77, 300, -1, "/etc/passwd", 0)
+	assert.Equal(t, Resolved{Path: "/etc/passwd"}, res)
+	r.loads.Wait()
+
+	r.mu.Lock()
+	e := r.mounts[300]
+	assert.Nil(t, e.infos)
+	assert.False(t, e.loading)
+	updated := e.updated
+	r.mu.Unlock()
+
+	r.Resolve(77, 300, -1, "/etc/passwd", 0)
+	r.loads.Wait()
+	r.mu.Lock()
+	assert.Equal(t, updated, r.mounts[300].updated)
+	r.mu.Unlock()
+}
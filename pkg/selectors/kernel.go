@@ -12,6 +12,7 @@ import (
 	"github.com/isovalent/tetragon-oss/api/v1/fgs"
 	"github.com/isovalent/tetragon-oss/pkg/k8s/apis/isovalent.com/v1alpha1"
 	"github.com/isovalent/tetragon-oss/pkg/kernels"
+	"github.com/isovalent/tetragon-oss/pkg/reader/bpf"
 	"github.com/isovalent/tetragon-oss/pkg/reader/namespace"
 	"github.com/isovalent/tetragon-oss/pkg/reader/path"
 )
@@ -106,6 +107,12 @@ const (
 
 	argTypeFile = 16
 	argTypeFd   = 17
+
+	argTypeBpfAttr     = 18
+	argTypeModule      = 19
+	argTypeLoadInfo    = 20
+	argTypeLinuxBinprm = 21
+	argTypeCapability  = 22
 )
 
 var argTypeTable = map[string]uint32{
@@ -122,6 +129,12 @@ var argTypeTable = map[string]uint32{
 	"fd":         argTypeFd,
 	"file":       argTypeFile,
 	"sock":       argTypeSock,
+
+	"bpf_attr":     argTypeBpfAttr,
+	"module":       argTypeModule,
+	"load_info":    argTypeLoadInfo,
+	"linux_binprm": argTypeLinuxBinprm,
+	"capability":   argTypeCapability,
 }
 
 var argTypeStringTable = map[uint32]string{
@@ -138,6 +151,12 @@ var argTypeStringTable = map[uint32]string{
 	argTypeFd:        "fd",
 	argTypeFile:      "file",
 	argTypeSock:      "sock",
+
+	argTypeBpfAttr:     "bpf_attr",
+	argTypeModule:      "module",
+	argTypeLoadInfo:    "load_info",
+	argTypeLinuxBinprm: "linux_binprm",
+	argTypeCapability:  "capability",
 }
 
 const (
@@ -261,7 +280,8 @@ func parseMatchValues(k *KernelSelectorState, values []string, ty uint32) error
 			value, size := ArgSelectorValue(swapV)
 			WriteSelectorUint32(k, size)
 			WriteSelectorByteArray(k, value, size)
-		case argTypeString, argTypeCharBuf:
+		case argTypeString, argTypeCharBuf, argTypeModule, argTypeLoadInfo, argTypeLinuxBinprm:
+			// modules are matched on their name, binprm on its filename
 			value, size := ArgSelectorValue(v)
 			WriteSelectorUint32(k, size)
 			WriteSelectorByteArray(k, value, size)
@@ -277,6 +297,28 @@ func parseMatchValues(k *KernelSelectorState, values []string, ty uint32) error
 				return fmt.Errorf("MatchArgs value %s invalid: %x", v, err)
 			}
 			WriteSelectorUint64(k, uint64(i))
+		case argTypeCapability:
+			// capabilities can be specified by name or by value
+			if c, ok := fgs.CapabilitiesType_value[strings.ToUpper(v)]; ok {
+				WriteSelectorUint32(k, uint32(c))
+				break
+			}
+			i, err := strconv.ParseInt(v, 10, 32)
+			if err != nil {
+				return fmt.Errorf("MatchArgs value %s invalid: not a capability", v)
+			}
+			WriteSelectorUint32(k, uint32(i))
+		case argTypeBpfAttr:
+			// bpf_attr is matched on the program type (name or value)
+			if t, ok := bpf.GetProgTypeValue(strings.ToUpper(v)); ok {
+				WriteSelectorUint32(k, t)
+				break
+			}
+			i, err := strconv.ParseInt(v, 10, 32)
+			if err != nil {
+				return fmt.Errorf("MatchArgs value %s invalid: not a bpf program type", v)
+			}
+			WriteSelectorUint32(k, uint32(i))
 		case argTypeSock, argTypeSkb, argTypeCharIovec:
 			return fmt.Errorf("MatchArgs values %s unsupported", v)
 		}
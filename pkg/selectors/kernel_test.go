@@ -210,6 +210,46 @@ func TestParseMatchArg(t *testing.T) {
 	}
 }
 
+func TestParseMatchArgCapabilityBpfAttr(t *testing.T) {
+	sig := []v1alpha1.KProbeArg{
+		v1alpha1.KProbeArg{Index: 0, Type: "capability", SizeArgIndex: 0, ReturnCopy: false},
+		v1alpha1.KProbeArg{Index: 1, Type: "bpf_attr", SizeArgIndex: 0, ReturnCopy: false},
+	}
+
+	arg1 := &v1alpha1.ArgSelector{Index: 0, Operator: "Equal", Values: []string{"CAP_SYS_ADMIN", "12"}}
+	k := &KernelSelectorState{off: 0}
+	expected1 := []byte{
+		0x00, 0x00, 0x00, 0x00, // Index == 0
+		0x03, 0x00, 0x00, 0x00, // operator == equal
+		16, 0x00, 0x00, 0x00, // length == 16
+		22, 0x00, 0x00, 0x00, // value type == capability
+		21, 0x00, 0x00, 0x00, // value CAP_SYS_ADMIN
+		12, 0x00, 0x00, 0x00, // value 12
+	}
+	if err := parseMatchArg(k, arg1, sig); err != nil || bytes.Equal(expected1, k.e[0:k.off]) == false {
+		t.Errorf("parseMatchArg: error %v expected %v bytes %v parsing %v\n", err, expected1, k.e[0:k.off], arg1)
+	}
+
+	arg2 := &v1alpha1.ArgSelector{Index: 1, Operator: "Equal", Values: []string{"BPF_PROG_TYPE_KPROBE"}}
+	k = &KernelSelectorState{off: 0}
+	expected2 := []byte{
+		0x01, 0x00, 0x00, 0x00, // Index == 1
+		0x03, 0x00, 0x00, 0x00, // operator == equal
+		12, 0x00, 0x00, 0x00, // length == 12
+		18, 0x00, 0x00, 0x00, // value type == bpf_attr
+		0x02, 0x00, 0x00, 0x00, // value BPF_PROG_TYPE_KPROBE
+	}
+	if err := parseMatchArg(k, arg2, sig); err != nil || bytes.Equal(expected2, k.e[0:k.off]) == false {
+		t.Errorf("parseMatchArg: error %v expected %v bytes %v parsing %v\n", err, expected2, k.e[0:k.off], arg2)
+	}
+
+	arg3 := &v1alpha1.ArgSelector{Index: 0, Operator: "Equal", Values: []string{"CAP_FOO"}}
+	k = &KernelSelectorState{off: 0}
+	if err := parseMatchArg(k, arg3, sig); err == nil {
+		t.Errorf("parseMatchArg: expected error parsing %v\n", arg3)
+	}
+}
+
 func TestParseMatchPid(t *testing.T) {
 	pid1 := &v1alpha1.PIDSelector{Operator: "In", Values: []uint32{1, 2, 3}, IsNamespacePID: true, FollowForks: true}
 	k := &KernelSelectorState{off: 0}
@@ -158,7 +158,7 @@ func (e *execSensor) LoadProbe(args sensors.LoadProbeArgs) (int, error) {
 	return i, err
 }
 
-func (e *execSensor) SpecHandler(spec *v1alpha1.TracingPolicySpec) (*sensors.Sensor, error) {
+func (e *execSensor) SpecHandler(policyName string, spec *v1alpha1.TracingPolicySpec) (*sensors.Sensor, error) {
 	return nil, nil
 }
 
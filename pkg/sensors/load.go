@@ -256,7 +256,7 @@ func createConfigSensors(configFile string) ([]*Sensor, error) {
 		return nil, err
 	}
 
-	return GetSensorsFromParserPolicy(&cnf.Spec)
+	return GetSensorsFromParserPolicy(cnf.Metadata.Name, &cnf.Spec)
 }
 
 func mergeSensors(sensors []*Sensor) *Sensor {
@@ -177,7 +177,9 @@ func LogRegisteredSensorsAndProbes() {
 }
 
 type tracingSensor interface {
-	SpecHandler(spec *v1alpha1.TracingPolicySpec) (*Sensor, error)
+	// SpecHandler creates the sensor for the policy named policyName. The
+	// policy name is reported in the events generated by the sensor.
+	SpecHandler(policyName string, spec *v1alpha1.TracingPolicySpec) (*Sensor, error)
 	LoadProbe(args LoadProbeArgs) (int, error)
 }
 
@@ -202,10 +204,10 @@ func RegisterSensorAtInit(s *Sensor) {
 	availableSensors[s.Name] = []*Sensor{s}
 }
 
-func GetSensorsFromParserPolicy(spec *v1alpha1.TracingPolicySpec) ([]*Sensor, error) {
+func GetSensorsFromParserPolicy(policyName string, spec *v1alpha1.TracingPolicySpec) ([]*Sensor, error) {
 	var sensors []*Sensor
 	for _, s := range registeredTracingSensors {
-		sensor, err := s.SpecHandler(spec)
+		sensor, err := s.SpecHandler(policyName, spec)
 		if err != nil {
 			return nil, err
 		}
@@ -51,7 +51,7 @@ func StartSensorManager(bpfDir, mapDir, ciliumDir string) (*Manager, error) {
 				}
 				sensors := []*Sensor{}
 				for _, s := range registeredTracingSensors {
-					sensor, err = s.SpecHandler(op.spec)
+					sensor, err = s.SpecHandler(op.sensorName, op.spec)
 					if err != nil {
 						break
 					}
@@ -0,0 +1,218 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+package tracing
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	api "github.com/isovalent/tetragon-oss/pkg/api/tracingapi"
+	"github.com/isovalent/tetragon-oss/pkg/bpf"
+	"github.com/isovalent/tetragon-oss/pkg/k8s/apis/isovalent.com/v1alpha1"
+	"github.com/isovalent/tetragon-oss/pkg/logger"
+	"github.com/isovalent/tetragon-oss/pkg/option"
+	"github.com/isovalent/tetragon-oss/pkg/reader/path"
+)
+
+const (
+	fdInstallMapName = "fdinstall_map"
+	// 256B paths + 4B length + 4B flags
+	fdInstallFileLen    = 264
+	fdInstallMaxPathLen = fdInstallFileLen - 8
+)
+
+type FdInstallMapKey struct {
+	Tid uint64
+	Fd  uint32
+	Pad uint32
+}
+
+func (k *FdInstallMapKey) String() string             { return fmt.Sprintf("tid: %d fd: %d", k.Tid, k.Fd) }
+func (k *FdInstallMapKey) NewValue() bpf.MapValue     { return &FdInstallMapValue{} }
+func (k *FdInstallMapKey) GetKeyPtr() unsafe.Pointer  { return unsafe.Pointer(k) }
+func (k *FdInstallMapKey) DeepCopyMapKey() bpf.MapKey { return &FdInstallMapKey{} }
+
+type FdInstallMapValue struct {
+	File [fdInstallFileLen]byte
+}
+
+func (v *FdInstallMapValue) String() string                 { return "fdinstall value" }
+func (v *FdInstallMapValue) NewValue() bpf.MapValue         { return &FdInstallMapValue{} }
+func (v *FdInstallMapValue) GetValuePtr() unsafe.Pointer    { return unsafe.Pointer(v) }
+func (v *FdInstallMapValue) DeepCopyMapValue() bpf.MapValue { return &FdInstallMapValue{} }
+
+// fdInstallMapEntry returns the fdinstall map entry for fd of process pid, as
+// the FollowFD action adds it in BPF. The path is stored in the BPF format,
+// i.e. with its components in reverse order.
+func fdInstallMapEntry(pid uint32, fd uint32, p string) (*FdInstallMapKey, *FdInstallMapValue, error) {
+	bpfPath := "/" + path.SwapPath(strings.TrimPrefix(p, "/"))
+	if len(bpfPath) > fdInstallMaxPathLen {
+		return nil, nil, fmt.Errorf("path too long: %s", p)
+	}
+
+	k := &FdInstallMapKey{
+		Tid: uint64(pid),
+		Fd:  fd,
+	}
+	v := &FdInstallMapValue{}
+	binary.LittleEndian.PutUint32(v.File[0:], uint32(len(bpfPath)))
+	copy(v.File[4:], bpfPath)
+	// flags are left to zero, the path is fully resolved
+	return k, v, nil
+}
+
+// writeFdInstallMap adds the entry for fd of process pid to the fdinstall
+// map, see fdInstallMapEntry.
+func writeFdInstallMap(m *bpf.Map, pid uint32, fd uint32, p string) error {
+	k, v, err := fdInstallMapEntry(pid, fd, p)
+	if err != nil {
+		return err
+	}
+	return m.Update(k, v)
+}
+
+// followFdSeed describes the files that a FollowFD action follows: the
+// file argument must match all the filters.
+type followFdSeed struct {
+	filters []v1alpha1.ArgSelector
+}
+
+func getFollowFdSeeds(spec *v1alpha1.KProbeSpec) []followFdSeed {
+	var seeds []followFdSeed
+
+	for _, s := range spec.Selectors {
+		for _, act := range s.MatchActions {
+			if strings.ToLower(act.Action) != "followfd" {
+				continue
+			}
+			seed := followFdSeed{}
+			for _, arg := range s.MatchArgs {
+				if arg.Index == act.ArgName {
+					seed.filters = append(seed.filters, arg)
+				}
+			}
+			seeds = append(seeds, seed)
+		}
+	}
+	return seeds
+}
+
+// matchArgFilter returns true if path p matches the filter of a file
+// argument, with the semantics of the BPF selectors: p must match one of the
+// values, or differ from all of them for negated operators.
+func matchArgFilter(filter *v1alpha1.ArgSelector, p string) bool {
+	op := strings.ToLower(filter.Operator)
+	switch op {
+	case "notequal", "neq":
+		for _, v := range filter.Values {
+			if p == v {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, v := range filter.Values {
+		var match bool
+
+		switch op {
+		case "equal", "eq":
+			match = p == v
+		case "prefix":
+			match = strings.HasPrefix(p, v)
+		case "postfix":
+			match = strings.HasSuffix(p, v)
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *followFdSeed) match(p string) bool {
+	for i := range s.filters {
+		if !matchArgFilter(&s.filters[i], p) {
+			return false
+		}
+	}
+	return true
+}
+
+// seedFdInstallMap adds the files that are already open when a policy with a
+// FollowFD action is loaded to the fdinstall map. Without this, only fds
+// installed after the policy was loaded are followed.
+func seedFdInstallMap(mapDir string, seeds []followFdSeed) error {
+	m, err := bpf.OpenMap(filepath.Join(mapDir, fdInstallMapName))
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	procs, err := ioutil.ReadDir(option.Config.ProcFS)
+	if err != nil {
+		return err
+	}
+
+	cnt := 0
+	for _, d := range procs {
+		pid, err := strconv.ParseUint(d.Name(), 10, 32)
+		if err != nil || !d.IsDir() {
+			continue
+		}
+		fdDir := filepath.Join(option.Config.ProcFS, d.Name(), "fd")
+		fds, err := ioutil.ReadDir(fdDir)
+		if err != nil {
+			continue
+		}
+		for _, f := range fds {
+			fd, err := strconv.ParseUint(f.Name(), 10, 32)
+			if err != nil {
+				continue
+			}
+			target, err := os.Readlink(filepath.Join(fdDir, f.Name()))
+			// skip sockets, pipes, anonymous inodes and deleted files
+			if err != nil || !strings.HasPrefix(target, "/") || strings.HasSuffix(target, " (deleted)") {
+				continue
+			}
+			for i := range seeds {
+				if !seeds[i].match(target) {
+					continue
+				}
+				if err := writeFdInstallMap(m, uint32(pid), uint32(fd), target); err != nil {
+					logger.GetLogger().WithError(err).Debugf("failed to follow fd %d of pid %d", fd, pid)
+				} else {
+					cnt++
+				}
+				break
+			}
+		}
+	}
+
+	logger.GetLogger().Infof("Followed %d already open files", cnt)
+	return nil
+}
+
+// resolveKprobeArgFd reads the name of an fd argument from procfs when it is
+// not available from BPF, i.e. when the fd was not followed. BPF then sends the
+// fd with an empty path.
+func resolveKprobeArgFd(arg api.MsgGenericKprobeArg, pid uint32) api.MsgGenericKprobeArg {
+	e, ok := arg.(api.MsgGenericKprobeArgFile)
+	if !ok || e.Fd < 0 || e.Value != "/" || e.ResolvedPath != "" {
+		return arg
+	}
+
+	link := filepath.Join(option.Config.ProcFS, strconv.FormatUint(uint64(pid), 10), "fd", strconv.FormatInt(int64(e.Fd), 10))
+	target, err := os.Readlink(link)
+	if err != nil || !strings.HasPrefix(target, "/") {
+		return arg
+	}
+	e.ResolvedPath = target
+	return e
+}
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package tracing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	api "github.com/isovalent/tetragon-oss/pkg/api/tracingapi"
+	gt "github.com/isovalent/tetragon-oss/pkg/generictypes"
+	"github.com/isovalent/tetragon-oss/pkg/k8s/apis/isovalent.com/v1alpha1"
+	"github.com/isovalent/tetragon-oss/pkg/option"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchArgFilter(t *testing.T) {
+	tests := []struct {
+		operator string
+		values   []string
+		path     string
+		match    bool
+	}{
+		{"Equal", []string{"/etc/passwd", "/etc/shadow"}, "/etc/shadow", true},
+		{"Equal", []string{"/etc/passwd", "/etc/shadow"}, "/etc/group", false},
+		{"eq", []string{"/etc/passwd"}, "/etc/passwd", true},
+		{"NotEqual", []string{"/etc/passwd", "/etc/shadow"}, "/etc/group", true},
+		{"NotEqual", []string{"/etc/passwd", "/etc/shadow"}, "/etc/passwd", false},
+		{"NotEqual", []string{"/etc/passwd", "/etc/shadow"}, "/etc/shadow", false},
+		{"neq", []string{"/etc/passwd"}, "/etc/passwd", false},
+		{"Prefix", []string{"/tmp/", "/etc/"}, "/etc/passwd", true},
+		{"Prefix", []string{"/tmp/"}, "/etc/passwd", false},
+		{"Postfix", []string{".conf", "passwd"}, "/etc/passwd", true},
+		{"Postfix", []string{".conf"}, "/etc/passwd", false},
+		{"Unknown", []string{"/etc/passwd"}, "/etc/passwd", false},
+	}
+	for _, tc := range tests {
+		filter := v1alpha1.ArgSelector{Operator: tc.operator, Values: tc.values}
+		assert.Equal(t, tc.match, matchArgFilter(&filter, tc.path), "%s %v %s", tc.operator, tc.values, tc.path)
+	}
+}
+
+func TestGetFollowFdSeeds(t *testing.T) {
+	etc := v1alpha1.ArgSelector{Index: 1, Operator: "Prefix", Values: []string{"/etc/"}}
+	other := v1alpha1.ArgSelector{Index: 0, Operator: "Equal", Values: []string{"3"}}
+	tests := []struct {
+		name      string
+		selectors []v1alpha1.KProbeSelector
+		seeds     []followFdSeed
+	}{
+		{
+			name:      "no followfd action",
+			selectors: []v1alpha1.KProbeSelector{{MatchArgs: []v1alpha1.ArgSelector{etc}, MatchActions: []v1alpha1.ActionSelector{{Action: "Post"}}}},
+		},
+		{
+			name: "filters of the followed argument",
+			selectors: []v1alpha1.KProbeSelector{{
+				MatchArgs:    []v1alpha1.ArgSelector{etc, other},
+				MatchActions: []v1alpha1.ActionSelector{{Action: "FollowFD", ArgFd: 0, ArgName: 1}},
+			}},
+			seeds: []followFdSeed{{filters: []v1alpha1.ArgSelector{etc}}},
+		},
+		{
+			name: "no filter",
+			selectors: []v1alpha1.KProbeSelector{{
+				MatchArgs:    []v1alpha1.ArgSelector{other},
+				MatchActions: []v1alpha1.ActionSelector{{Action: "followfd", ArgFd: 0, ArgName: 1}},
+			}},
+			seeds: []followFdSeed{{}},
+		},
+		{
+			name: "several selectors",
+			selectors: []v1alpha1.KProbeSelector{
+				{MatchArgs: []v1alpha1.ArgSelector{etc}, MatchActions: []v1alpha1.ActionSelector{{Action: "FollowFD", ArgName: 1}}},
+				{MatchActions: []v1alpha1.ActionSelector{{Action: "Sigkill"}}},
+				{MatchArgs: []v1alpha1.ArgSelector{other}, MatchActions: []v1alpha1.ActionSelector{{Action: "FollowFD", ArgName: 0}}},
+			},
+			seeds: []followFdSeed{
+				{filters: []v1alpha1.ArgSelector{etc}},
+				{filters: []v1alpha1.ArgSelector{other}},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := v1alpha1.KProbeSpec{Selectors: tc.selectors}
+			assert.Equal(t, tc.seeds, getFollowFdSeeds(&spec))
+		})
+	}
+}
+
+func TestFollowFdSeedMatch(t *testing.T) {
+	seed := followFdSeed{filters: []v1alpha1.ArgSelector{
+		{Operator: "Prefix", Values: []string{"/etc/"}},
+		{Operator: "NotEqual", Values: []string{"/etc/passwd", "/etc/group"}},
+	}}
+	assert.True(t, seed.match("/etc/shadow"))
+	assert.False(t, seed.match("/etc/passwd"))
+	assert.False(t, seed.match("/etc/group"))
+	assert.False(t, seed.match("/tmp/file"))
+	assert.True(t, (&followFdSeed{}).match("/tmp/file"))
+}
+
+func TestFdInstallMapEntry(t *testing.T) {
+	tests := []struct {
+		path    string
+		bpfPath string
+	}{
+		{"/etc/passwd", "/passwd/etc"},
+		{"/a/b/c", "/c/b/a"},
+		{"/file", "/file"},
+	}
+	for _, tc := range tests {
+		k, v, err := fdInstallMapEntry(42, 3, tc.path)
+		require.NoError(t, err)
+		assert.Equal(t, &FdInstallMapKey{Tid: 42, Fd: 3}, k)
+		length := binary.LittleEndian.Uint32(v.File[0:])
+		assert.Equal(t, tc.bpfPath, string(v.File[4:4+length]), tc.path)
+	}
+
+	_, _, err := fdInstallMapEntry(42, 3, "/"+strings.Repeat("a", fdInstallMaxPathLen))
+	assert.Error(t, err)
+	_, _, err = fdInstallMapEntry(42, 3, "/"+strings.Repeat("a", fdInstallMaxPathLen-2))
+	assert.NoError(t, err)
+}
+
+func TestResolveKprobeArgFdNotFollowed(t *testing.T) {
+	procFS := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(procFS, "42/fd"), 0755))
+	require.NoError(t, os.Symlink("/etc/passwd", filepath.Join(procFS, "42/fd/3")))
+	require.NoError(t, os.Symlink("pipe:[1234]", filepath.Join(procFS, "42/fd/4")))
+	oldProcFS := option.Config.ProcFS
+	option.Config.ProcFS = procFS
+	t.Cleanup(func() { option.Config.ProcFS = oldProcFS })
+
+	// argument of an fd missing from fdinstall_map, as sent by BPF: the
+	// fd, an empty path and no flags
+	notFollowed := func(fd int32) api.MsgGenericKprobeArg {
+		var buf bytes.Buffer
+		binary.Write(&buf, binary.LittleEndian, fd)
+		binary.Write(&buf, binary.LittleEndian, uint32(0))
+		binary.Write(&buf, binary.LittleEndian, uint32(0))
+		return getKprobeArg(bytes.NewReader(buf.Bytes()), argPrinters{ty: gt.GenericFdType, index: 1})
+	}
+
+	arg, ok := resolveKprobeArgFd(notFollowed(3), 42).(api.MsgGenericKprobeArgFile)
+	require.True(t, ok)
+	assert.Equal(t, api.MsgGenericKprobeArgFile{Index: 1, Value: "/", Fd: 3, ResolvedPath: "/etc/passwd"}, arg)
+
+	// not a file
+	arg, ok = resolveKprobeArgFd(notFollowed(4), 42).(api.MsgGenericKprobeArgFile)
+	require.True(t, ok)
+	assert.Empty(t, arg.ResolvedPath)
+	// closed fd
+	arg, ok = resolveKprobeArgFd(notFollowed(5), 42).(api.MsgGenericKprobeArgFile)
+	require.True(t, ok)
+	assert.Empty(t, arg.ResolvedPath)
+
+	// followed fds are not resolved
+	followed := api.MsgGenericKprobeArgFile{Index: 1, Value: "/etc/shadow", Fd: 3}
+	assert.Equal(t, followed, resolveKprobeArgFd(followed, 42))
+}
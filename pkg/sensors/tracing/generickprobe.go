@@ -13,7 +13,10 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
+	"text/template"
 
+	"github.com/isovalent/tetragon-oss/pkg/api/dataapi"
 	"github.com/isovalent/tetragon-oss/pkg/api/ops"
 	api "github.com/isovalent/tetragon-oss/pkg/api/tracingapi"
 	"github.com/isovalent/tetragon-oss/pkg/bpf"
@@ -25,6 +28,7 @@ import (
 	"github.com/isovalent/tetragon-oss/pkg/observer"
 	"github.com/isovalent/tetragon-oss/pkg/option"
 	"github.com/isovalent/tetragon-oss/pkg/reader/network"
+	readerpath "github.com/isovalent/tetragon-oss/pkg/reader/path"
 	"github.com/isovalent/tetragon-oss/pkg/selectors"
 	"github.com/isovalent/tetragon-oss/pkg/sensors"
 
@@ -55,6 +59,7 @@ const (
 	arg4            = "arg4"
 	argreturn       = "argreturn"
 	argreturncopy   = "argreturncopy"
+	argreturncopym  = "argreturncopym"
 	is_syscall      = "syscall"
 	argm0           = "arg0m"
 	argm1           = "arg1m"
@@ -127,8 +132,9 @@ type kprobeLoadArgs struct {
 }
 
 type argPrinters struct {
-	ty    int
-	index int
+	ty      int
+	index   int
+	maxData bool
 }
 
 // internal genericKprobe info
@@ -137,12 +143,18 @@ type genericKprobe struct {
 	argSigPrinters    []argPrinters
 	argReturnPrinters []argPrinters
 	funcName          string
+	policyName        string
+	message           string
 
 	// userReturnFilters are filter specs implemented in userspace after
 	// receiving events on the return value. We currently use this for return
 	// arg filtering.
 	userReturnFilters []v1alpha1.ArgSelector
 
+	// followFdSeeds are used to follow files that are already open when
+	// the sensor is loaded, see seedFdInstallMap()
+	followFdSeeds []followFdSeed
+
 	// for kprobes that have a retprobe, we maintain the enter events in
 	// the map, so that we can merge them when the return event is
 	// generated. The events are maintained in the map below, using
@@ -198,6 +210,7 @@ var (
 
 const (
 	argReturnCopyBit = 1 << 4
+	argMaxDataShift  = 8
 )
 
 func argReturnCopy(meta int) bool {
@@ -208,6 +221,7 @@ func argReturnCopy(meta int) bool {
 // bits
 //  0-3 : SizeArgIndex
 //    4 : ReturnCopy
+//  8-  : MaxData
 func getMetaValue(arg *v1alpha1.KProbeArg) (int, error) {
 	var meta int
 
@@ -220,6 +234,16 @@ func getMetaValue(arg *v1alpha1.KProbeArg) (int, error) {
 	if arg.ReturnCopy {
 		meta = meta | argReturnCopyBit
 	}
+	if arg.MaxData > 0 {
+		argType := gt.GenericTypeFromString(arg.Type)
+		if argType != gt.GenericCharBuffer && argType != gt.GenericCharIovec {
+			return 0, fmt.Errorf("maxData is supported only for char_buf and char_iovec types, got '%s'", arg.Type)
+		}
+		if arg.MaxData > dataapi.MaxDataSize {
+			return 0, fmt.Errorf("invalid maxData value (>%d): %v", dataapi.MaxDataSize, arg.MaxData)
+		}
+		meta = meta | int(arg.MaxData)<<argMaxDataShift
+	}
 	return meta, nil
 }
 
@@ -234,7 +258,16 @@ func initBinaryNames(spec *v1alpha1.KProbeSpec) error {
 	return nil
 }
 
-func addGenericKprobeSensors(kprobes []v1alpha1.KProbeSpec, btfBaseFile string) (*sensors.Sensor, error) {
+// checkMessage checks the message template of a kprobe or tracepoint spec, which
+// is rendered by the compact encoder.
+func checkMessage(message string) error {
+	if _, err := template.New("message").Parse(message); err != nil {
+		return fmt.Errorf("invalid message template: %w", err)
+	}
+	return nil
+}
+
+func addGenericKprobeSensors(kprobes []v1alpha1.KProbeSpec, btfBaseFile string, policyName string) (*sensors.Sensor, error) {
 	var progs []*sensors.Program
 
 	btfobj := bpf.BTFNil
@@ -256,6 +289,10 @@ func addGenericKprobeSensors(kprobes []v1alpha1.KProbeSpec, btfBaseFile string)
 		argRetprobe = nil // holds pointer to arg for return handler
 		funcName := f.Call
 
+		if err := checkMessage(f.Message); err != nil {
+			return nil, fmt.Errorf("kprobe %s: %w", funcName, err)
+		}
+
 		// Write args into BTF ptr for use with load
 		var err error
 		btfobj, err = btf.NewBTF()
@@ -303,7 +340,7 @@ func addGenericKprobeSensors(kprobes []v1alpha1.KProbeSpec, btfBaseFile string)
 			}
 
 			argsBTFSet[a.Index] = true
-			argP := argPrinters{index: j, ty: argType}
+			argP := argPrinters{index: j, ty: argType, maxData: a.MaxData > 0 && !a.ReturnCopy}
 			argSigPrinters = append(argSigPrinters, argP)
 		}
 
@@ -349,13 +386,26 @@ func addGenericKprobeSensors(kprobes []v1alpha1.KProbeSpec, btfBaseFile string)
 				return nil, fmt.Errorf("Error add enum value '%s'='0' failed %d", argreturncopy, argType)
 			}
 
-			argP := argPrinters{index: int(argRetprobe.Index), ty: argType}
+			argMValue, err := getMetaValue(argRetprobe)
+			if err != nil {
+				return nil, err
+			}
+			ret = btfobj.AddEnumValue(argreturncopym, argMValue)
+			if ret < 0 {
+				return nil, fmt.Errorf("Error add enum value '%s'='%d' failed %d", argreturncopym, argMValue, ret)
+			}
+
+			argP := argPrinters{index: int(argRetprobe.Index), ty: argType, maxData: argRetprobe.MaxData > 0}
 			argReturnPrinters = append(argReturnPrinters, argP)
 		} else {
 			ret = btfobj.AddEnumValue(argreturncopy, 0)
 			if ret < 0 {
 				return nil, fmt.Errorf("Error add enum value '%s'='0' failed %d", argreturncopy, 0)
 			}
+			ret = btfobj.AddEnumValue(argreturncopym, 0)
+			if ret < 0 {
+				return nil, fmt.Errorf("Error add enum value '%s'='0' failed %d", argreturncopym, ret)
+			}
 		}
 
 		// Mark remaining arguments as 'nops' the kernel side will skip
@@ -445,7 +495,10 @@ func addGenericKprobeSensors(kprobes []v1alpha1.KProbeSpec, btfBaseFile string)
 			argSigPrinters:    argSigPrinters,
 			argReturnPrinters: argReturnPrinters,
 			userReturnFilters: userReturnFilters,
+			followFdSeeds:     getFollowFdSeeds(f),
 			funcName:          funcName,
+			policyName:        policyName,
+			message:           f.Message,
 			pendingEvents:     map[uint64]pendingEvent{},
 			tableId:           idtable.UninitializedEntryID,
 		}
@@ -570,7 +623,15 @@ func loadGenericKprobeSensor(bpfDir, mapDir string, load *sensors.Program, versi
 		return 0, loadGenericKprobeRet(bpfDir, mapDir, version, load, gk.loadArgs.btf, genmapDir)
 	}
 
-	return 0, loadGenericKprobe(bpfDir, mapDir, version, load, gk.loadArgs.btf, genmapDir, gk.loadArgs.filters)
+	if err := loadGenericKprobe(bpfDir, mapDir, version, load, gk.loadArgs.btf, genmapDir, gk.loadArgs.filters); err != nil {
+		return 0, err
+	}
+	if len(gk.followFdSeeds) > 0 {
+		if err := seedFdInstallMap(mapDir, gk.followFdSeeds); err != nil {
+			logger.GetLogger().WithError(err).Warnf("Failed to follow already open files for %s", gk.funcName)
+		}
+	}
+	return 0, nil
 }
 
 func handleGenericKprobeString(r *bytes.Reader) string {
@@ -600,6 +661,32 @@ func handleGenericKprobeString(r *bytes.Reader) string {
 	return strVal
 }
 
+// readArgString reads a string argument (size followed by the string) and
+// strips the trailing NUL byte.
+func readArgString(r *bytes.Reader) string {
+	var b int32
+
+	err := binary.Read(r, binary.LittleEndian, &b)
+	if err != nil {
+		logger.GetLogger().WithError(err).Warnf("StringSz type err")
+	}
+	if b < 0 {
+		b = 0
+	}
+	outputStr := make([]byte, b)
+	err = binary.Read(r, binary.LittleEndian, &outputStr)
+	if err != nil {
+		logger.GetLogger().WithError(err).Warnf("String with size %d type err", b)
+	}
+
+	strVal := string(outputStr[:])
+	lenStrVal := len(strVal)
+	if lenStrVal > 0 && strVal[lenStrVal-1] == '\x00' {
+		strVal = strVal[0 : lenStrVal-1]
+	}
+	return strVal
+}
+
 func ReadArgBytes(r *bytes.Reader, index int) (*api.MsgGenericKprobeArgBytes, error) {
 	var bytes, bytes_rd int32
 	var arg api.MsgGenericKprobeArgBytes
@@ -637,6 +724,259 @@ func ReadArgBytes(r *bytes.Reader, index int) (*api.MsgGenericKprobeArgBytes, er
 
 }
 
+// ReadArgData reads a buffer argument that was sent as data events (see the
+// maxData argument option). The argument itself only carries a descriptor of
+// the data, which is retrieved from the observer.
+func ReadArgData(r *bytes.Reader, index int) (*api.MsgGenericKprobeArgBytes, error) {
+	var desc dataapi.DataEventDesc
+	var arg api.MsgGenericKprobeArgBytes
+
+	if err := binary.Read(r, binary.LittleEndian, &desc); err != nil {
+		return nil, fmt.Errorf("failed to read data descriptor for buffer argument: %w", err)
+	}
+
+	arg.Index = uint64(index)
+	// bpf-side returned an error
+	if desc.Error < 0 {
+		arg.Value = []byte(fmt.Sprintf("CharBufErrorDataEvent(%d)", desc.Error))
+		return &arg, nil
+	}
+	arg.OrigSize = uint64(desc.Size) + uint64(desc.Leftover)
+
+	data, err := observer.DataGet(&desc)
+	if err != nil {
+		// report the argument without data, the data events were lost
+		// or evicted before this event was received.
+		logger.GetLogger().WithError(err).Warnf("failed to get data for buffer argument")
+		return &arg, nil
+	}
+	arg.Value = data
+	return &arg, nil
+}
+
+// getKprobeArg reads an argument of type a.ty from r. It returns nil if the
+// argument could not be read.
+func getKprobeArg(r *bytes.Reader, a argPrinters) api.MsgGenericKprobeArg {
+	switch a.ty {
+	case gt.GenericIntType:
+		var output int32
+		var arg api.MsgGenericKprobeArgInt
+
+		err := binary.Read(r, binary.LittleEndian, &output)
+		if err != nil {
+			logger.GetLogger().WithError(err).Warnf("Int type error")
+		}
+
+		arg.Index = uint64(a.index)
+		arg.Value = output
+		return arg
+	case gt.GenericFileType, gt.GenericFdType:
+		var arg api.MsgGenericKprobeArgFile
+		var flags uint32
+		var b int32
+
+		/* The file descriptor is only used to resolve the path in userland */
+		arg.Fd = -1
+		if a.ty == gt.GenericFdType {
+			binary.Read(r, binary.LittleEndian, &b)
+			arg.Fd = b
+		}
+
+		arg.Index = uint64(a.index)
+		arg.Value = handleGenericKprobeString(r) + "/"
+
+		// read the first byte that keeps the flags
+		err := binary.Read(r, binary.LittleEndian, &flags)
+		if err != nil {
+			flags = 0
+		}
+
+		arg.Flags = flags
+		return arg
+	case gt.GenericPathType:
+		var arg api.MsgGenericKprobeArgPath
+		var flags uint32
+
+		arg.Index = uint64(a.index)
+		arg.Value = handleGenericKprobeString(r) + "/"
+
+		// read the first byte that keeps the flags
+		err := binary.Read(r, binary.LittleEndian, &flags)
+		if err != nil {
+			flags = 0
+		}
+
+		arg.Flags = flags
+		return arg
+	case gt.GenericFilenameType, gt.GenericStringType:
+		var arg api.MsgGenericKprobeArgString
+
+		arg.Index = uint64(a.index)
+		arg.Value = readArgString(r)
+		return arg
+	case gt.GenericCredType:
+		var cred api.MsgGenericKprobeCred
+		var arg api.MsgGenericKprobeArgCred
+
+		err := binary.Read(r, binary.LittleEndian, &cred)
+		if err != nil {
+			logger.GetLogger().WithError(err).Warnf("cred type err")
+		}
+
+		arg.Index = uint64(a.index)
+		arg.Permitted = cred.Permitted
+		arg.Effective = cred.Effective
+		arg.Inheritable = cred.Inheritable
+		arg.Uid = cred.Uid
+		arg.Gid = cred.Gid
+		arg.Suid = cred.Suid
+		arg.Sgid = cred.Sgid
+		arg.Euid = cred.Euid
+		arg.Egid = cred.Egid
+		arg.FSuid = cred.FSuid
+		arg.FSgid = cred.FSgid
+		arg.SecureBits = cred.SecureBits
+		return arg
+	case gt.GenericBpfAttr:
+		var attr api.MsgGenericKprobeBpfAttr
+		var arg api.MsgGenericKprobeArgBpfAttr
+
+		err := binary.Read(r, binary.LittleEndian, &attr)
+		if err != nil {
+			logger.GetLogger().WithError(err).Warnf("bpf_attr type err")
+		}
+
+		arg.Index = uint64(a.index)
+		arg.ProgType = attr.ProgType
+		arg.InsnCnt = attr.InsnCnt
+		progName := attr.ProgName[:]
+		if i := bytes.IndexByte(progName, 0); i >= 0 {
+			progName = progName[:i]
+		}
+		arg.ProgName = string(progName)
+		return arg
+	case gt.GenericModuleType, gt.GenericLoadInfoType:
+		var arg api.MsgGenericKprobeArgModule
+
+		arg.Index = uint64(a.index)
+		arg.Name = readArgString(r)
+		return arg
+	case gt.GenericLinuxBinprm:
+		var arg api.MsgGenericKprobeArgLinuxBinprm
+
+		arg.Index = uint64(a.index)
+		arg.Path = readArgString(r)
+		arg.Interpreter = readArgString(r)
+		return arg
+	case gt.GenericCapabilityType:
+		var output int32
+		var arg api.MsgGenericKprobeArgCapability
+
+		err := binary.Read(r, binary.LittleEndian, &output)
+		if err != nil {
+			logger.GetLogger().WithError(err).Warnf("capability type error")
+		}
+
+		arg.Index = uint64(a.index)
+		arg.Value = output
+		return arg
+	case gt.GenericCharBuffer, gt.GenericCharIovec:
+		readArg := ReadArgBytes
+		if a.maxData {
+			readArg = ReadArgData
+		}
+		if arg, err := readArg(r, a.index); err == nil {
+			return *arg
+		} else {
+			logger.GetLogger().WithError(err).Warnf("failed to read bytes argument")
+		}
+	case gt.GenericSkbType:
+		var skb api.MsgGenericKprobeSkb
+		var arg api.MsgGenericKprobeArgSkb
+
+		err := binary.Read(r, binary.LittleEndian, &skb)
+		if err != nil {
+			logger.GetLogger().WithError(err).Warnf("skb type err")
+		}
+
+		arg.Index = uint64(a.index)
+		arg.Hash = skb.Hash
+		arg.Len = skb.Len
+		arg.Priority = skb.Priority
+		arg.Mark = skb.Mark
+		arg.Saddr = network.GetIP(skb.Saddr, 0).String()
+		arg.Daddr = network.GetIP(skb.Daddr, 0).String()
+		arg.Sport = uint32(network.SwapByte(uint16(skb.Sport)))
+		arg.Dport = uint32(network.SwapByte(uint16(skb.Dport)))
+		arg.Proto = skb.Proto
+		arg.SecPathLen = skb.SecPathLen
+		arg.SecPathOLen = skb.SecPathOLen
+		return arg
+	case gt.GenericSockType:
+		var sock api.MsgGenericKprobeSock
+		var arg api.MsgGenericKprobeArgSock
+
+		err := binary.Read(r, binary.LittleEndian, &sock)
+		if err != nil {
+			logger.GetLogger().WithError(err).Warnf("sock type err")
+		}
+
+		arg.Index = uint64(a.index)
+		arg.Family = sock.Family
+		arg.Type = sock.Type
+		arg.Protocol = sock.Protocol
+		arg.Mark = sock.Mark
+		arg.Priority = sock.Priority
+		arg.Saddr = network.GetIP(sock.Daddr, 0).String()
+		arg.Daddr = network.GetIP(sock.Saddr, 0).String()
+		arg.Sport = uint32(network.SwapByte(sock.Sport))
+		arg.Dport = uint32(network.SwapByte(sock.Dport))
+		return arg
+	case gt.GenericSizeType:
+		var output uint64
+		var arg api.MsgGenericKprobeArgSize
+
+		err := binary.Read(r, binary.LittleEndian, &output)
+		if err != nil {
+			logger.GetLogger().WithError(err).Warnf("Size type error")
+		}
+
+		arg.Index = uint64(a.index)
+		arg.Value = output
+		return arg
+	default:
+		logger.GetLogger().WithField("event", a).Warnf("Unknown type event")
+	}
+	return nil
+}
+
+var (
+	pathResolver     *readerpath.Resolver
+	pathResolverOnce sync.Once
+)
+
+// resolveKprobeArgPath completes file and path arguments in userspace, see
+// readerpath.Resolver.
+func resolveKprobeArgPath(arg api.MsgGenericKprobeArg, pid uint32, mntns uint32) api.MsgGenericKprobeArg {
+	pathResolverOnce.Do(func() {
+		pathResolver = readerpath.NewResolver(option.Config.ProcFS)
+	})
+
+	switch e := arg.(type) {
+	case api.MsgGenericKprobeArgFile:
+		res := pathResolver.Resolve(pid, mntns, e.Fd, readerpath.GenPath(e.Value), e.Flags)
+		e.ResolvedPath = res.Path
+		e.HostPath = res.HostPath
+		return e
+	case api.MsgGenericKprobeArgPath:
+		res := pathResolver.Resolve(pid, mntns, -1, readerpath.GenPath(e.Value), e.Flags)
+		e.ResolvedPath = res.Path
+		e.HostPath = res.HostPath
+		return e
+	}
+	return arg
+}
+
 func handleGenericKprobe(r *bytes.Reader) ([]observer.Event, error) {
 	m := api.MsgGenericKprobe{}
 	err := binary.Read(r, binary.LittleEndian, &m)
@@ -657,6 +997,8 @@ func handleGenericKprobe(r *bytes.Reader) ([]observer.Event, error) {
 	unix.Id = m.Id
 	unix.Action = m.ActionId
 	unix.FuncName = gk.funcName
+	unix.PolicyName = gk.policyName
+	unix.Message = gk.message
 	unix.Namespaces = m.Namespaces
 	unix.Capabilities = m.Capabilities
 
@@ -669,153 +1011,12 @@ func handleGenericKprobe(r *bytes.Reader) ([]observer.Event, error) {
 		printers = gk.argSigPrinters
 	}
 	for _, a := range printers {
-		switch a.ty {
-		case gt.GenericIntType:
-			var output int32
-			var arg api.MsgGenericKprobeArgInt
-
-			err := binary.Read(r, binary.LittleEndian, &output)
-			if err != nil {
-				logger.GetLogger().WithError(err).Warnf("Int type error")
-			}
-
-			arg.Index = uint64(a.index)
-			arg.Value = output
-			unix.Args = append(unix.Args, arg)
-		case gt.GenericFileType, gt.GenericFdType:
-			var arg api.MsgGenericKprobeArgFile
-			var flags uint32
-			var b int32
-
-			/* Eat file descriptor its not used in userland */
-			if a.ty == gt.GenericFdType {
-				binary.Read(r, binary.LittleEndian, &b)
-			}
-
-			arg.Index = uint64(a.index)
-			arg.Value = handleGenericKprobeString(r) + "/"
-
-			// read the first byte that keeps the flags
-			err := binary.Read(r, binary.LittleEndian, &flags)
-			if err != nil {
-				flags = 0
-			}
-
-			arg.Flags = flags
-			unix.Args = append(unix.Args, arg)
-		case gt.GenericPathType:
-			var arg api.MsgGenericKprobeArgPath
-			var flags uint32
-
-			arg.Index = uint64(a.index)
-			arg.Value = handleGenericKprobeString(r) + "/"
-
-			// read the first byte that keeps the flags
-			err := binary.Read(r, binary.LittleEndian, &flags)
-			if err != nil {
-				flags = 0
-			}
-
-			arg.Flags = flags
-			unix.Args = append(unix.Args, arg)
-		case gt.GenericFilenameType, gt.GenericStringType:
-			var b int32
-			var arg api.MsgGenericKprobeArgString
-
-			err := binary.Read(r, binary.LittleEndian, &b)
-			if err != nil {
-				logger.GetLogger().WithError(err).Warnf("StringSz type err")
-			}
-			outputStr := make([]byte, b)
-			err = binary.Read(r, binary.LittleEndian, &outputStr)
-			if err != nil {
-				logger.GetLogger().WithError(err).Warnf("String with size %d type err", b)
-			}
-
-			arg.Index = uint64(a.index)
-			strVal := string(outputStr[:])
-			lenStrVal := len(strVal)
-			if lenStrVal > 0 && strVal[lenStrVal-1] == '\x00' {
-				strVal = strVal[0 : lenStrVal-1]
-			}
-			arg.Value = strVal
-			unix.Args = append(unix.Args, arg)
-		case gt.GenericCredType:
-			var cred api.MsgGenericKprobeCred
-			var arg api.MsgGenericKprobeArgCred
-
-			err := binary.Read(r, binary.LittleEndian, &cred)
-			if err != nil {
-				logger.GetLogger().WithError(err).Warnf("cred type err")
-			}
-
-			arg.Index = uint64(a.index)
-			arg.Permitted = cred.Permitted
-			arg.Effective = cred.Effective
-			arg.Inheritable = cred.Inheritable
-			unix.Args = append(unix.Args, arg)
-		case gt.GenericCharBuffer, gt.GenericCharIovec:
-			if arg, err := ReadArgBytes(r, a.index); err == nil {
-				unix.Args = append(unix.Args, *arg)
-			} else {
-				logger.GetLogger().WithError(err).Warnf("failed to read bytes argument")
+		if arg := getKprobeArg(r, a); arg != nil {
+			arg = resolveKprobeArgFd(arg, m.ProcessKey.Pid)
+			if option.Config.EnablePathResolution {
+				arg = resolveKprobeArgPath(arg, m.ProcessKey.Pid, m.Namespaces.MntInum)
 			}
-		case gt.GenericSkbType:
-			var skb api.MsgGenericKprobeSkb
-			var arg api.MsgGenericKprobeArgSkb
-
-			err := binary.Read(r, binary.LittleEndian, &skb)
-			if err != nil {
-				logger.GetLogger().WithError(err).Warnf("skb type err")
-			}
-
-			arg.Index = uint64(a.index)
-			arg.Hash = skb.Hash
-			arg.Len = skb.Len
-			arg.Priority = skb.Priority
-			arg.Mark = skb.Mark
-			arg.Saddr = network.GetIP(skb.Saddr, 0).String()
-			arg.Daddr = network.GetIP(skb.Daddr, 0).String()
-			arg.Sport = uint32(network.SwapByte(uint16(skb.Sport)))
-			arg.Dport = uint32(network.SwapByte(uint16(skb.Dport)))
-			arg.Proto = skb.Proto
-			arg.SecPathLen = skb.SecPathLen
-			arg.SecPathOLen = skb.SecPathOLen
-			unix.Args = append(unix.Args, arg)
-		case gt.GenericSockType:
-			var sock api.MsgGenericKprobeSock
-			var arg api.MsgGenericKprobeArgSock
-
-			err := binary.Read(r, binary.LittleEndian, &sock)
-			if err != nil {
-				logger.GetLogger().WithError(err).Warnf("sock type err")
-			}
-
-			arg.Index = uint64(a.index)
-			arg.Family = sock.Family
-			arg.Type = sock.Type
-			arg.Protocol = sock.Protocol
-			arg.Mark = sock.Mark
-			arg.Priority = sock.Priority
-			arg.Saddr = network.GetIP(sock.Daddr, 0).String()
-			arg.Daddr = network.GetIP(sock.Saddr, 0).String()
-			arg.Sport = uint32(network.SwapByte(sock.Sport))
-			arg.Dport = uint32(network.SwapByte(sock.Dport))
-			unix.Args = append(unix.Args, arg)
-		case gt.GenericSizeType:
-			var output uint64
-			var arg api.MsgGenericKprobeArgSize
-
-			err := binary.Read(r, binary.LittleEndian, &output)
-			if err != nil {
-				logger.GetLogger().WithError(err).Warnf("Size type error sizeof %d", m.Common.Size)
-			}
-
-			arg.Index = uint64(a.index)
-			arg.Value = output
 			unix.Args = append(unix.Args, arg)
-		default:
-			logger.GetLogger().WithError(err).WithField("event", a).Warnf("Unknown type event")
 		}
 	}
 
@@ -940,12 +1141,12 @@ func retprobeMerge(prev pendingEvent, curr pendingEvent) (*api.MsgGenericKprobeU
 	return enterEv, ret
 }
 
-func (k *observerKprobeSensor) SpecHandler(spec *v1alpha1.TracingPolicySpec) (*sensors.Sensor, error) {
+func (k *observerKprobeSensor) SpecHandler(policyName string, spec *v1alpha1.TracingPolicySpec) (*sensors.Sensor, error) {
 	if len(spec.KProbes) > 0 && len(spec.Tracepoints) > 0 {
 		return nil, errors.New("tracing policies with both kprobes and tracepoints are not currently supported")
 	}
 	if len(spec.KProbes) > 0 {
-		return addGenericKprobeSensors(spec.KProbes, option.Config.BTF)
+		return addGenericKprobeSensors(spec.KProbes, option.Config.BTF, policyName)
 	}
 	return nil, nil
 }
@@ -34,6 +34,11 @@ const (
 	genericTP_OutputSize = 9000
 	// maximum arguments that bpf-side supports
 	genericTP_MaxArgs = 5
+
+	// tracepoint types (see TracepointSpec.Type)
+	tracepointTypeClassic = ""
+	tracepointTypeRaw     = "raw"
+	tracepointTypeBtf     = "btf"
 )
 
 var (
@@ -65,10 +70,52 @@ type genericTracepoint struct {
 
 	Selectors *v1alpha1.TracepointSpec
 
+	// tracepoint type: classic, raw, or btf
+	Type string
+	// arguments of raw and btf tracepoints, as found in the kernel BTF
+	btfArgs []btf.TracepointArgInfo
+
+	// name of the tracing policy that created the tracepoint
+	policyName string
+
 	// index to access this on genericTracepointTable
 	tableIdx int
 }
 
+// isRaw returns true if the arguments of the tracepoint are passed to the
+// program as an array of u64 (raw_tracepoint and tp_btf programs)
+func (tp *genericTracepoint) isRaw() bool {
+	return tp.Type == tracepointTypeRaw || tp.Type == tracepointTypeBtf
+}
+
+// newArg creates a new argument for the tpIdx tracepoint argument
+func (tp *genericTracepoint) newArg(tpIdx int) (genericTracepointArg, error) {
+	ret := genericTracepointArg{
+		ArgIdx:        uint32(len(tp.args)),
+		TpIdx:         tpIdx,
+		genericTypeId: gt.GenericInvalidType,
+	}
+
+	if tp.isRaw() {
+		if tpIdx >= len(tp.btfArgs) {
+			return ret, fmt.Errorf("tracepoint %s/%s has %d arguments but argument %d was requested",
+				tp.Info.Subsys, tp.Info.Event, len(tp.btfArgs), tpIdx)
+		}
+		ret.CtxOffset = 8 * tpIdx
+		ret.btfArg = &tp.btfArgs[tpIdx]
+		return ret, nil
+	}
+
+	if tpIdx >= len(tp.Info.Format.Fields) {
+		return ret, fmt.Errorf("tracepoint %s/%s has %d fields but field %d was requested",
+			tp.Info.Subsys, tp.Info.Event, len(tp.Info.Format.Fields), tpIdx)
+	}
+	field := tp.Info.Format.Fields[tpIdx]
+	ret.CtxOffset = int(field.Offset)
+	ret.format = &field
+	return ret, nil
+}
+
 // genericTracepointArg is the internal representation of an output value of a
 // generic tracepoint.
 type genericTracepointArg struct {
@@ -91,8 +138,12 @@ type genericTracepointArg struct {
 	// metadata but are not part of the output.
 	nopTy bool
 
-	// format of the field
+	// format of the field (classic tracepoints)
 	format *tracepoint.FieldFormat
+	// BTF information of the argument (raw and btf tracepoints)
+	btfArg *btf.TracepointArgInfo
+	// user-specified type, overrides the type deduced from format or btfArg
+	userType string
 
 	// bpf generic type
 	genericTypeId int
@@ -144,28 +195,20 @@ func getTracepointMetaValue(arg *GenericTracepointConfArg) int {
 // NB: making this a method of GenericTracepointConfArg means that we can have
 // this as an interface (e.g,. for implementing output by name)
 func (conf *GenericTracepointConfArg) configureTracepointArg(tp *genericTracepoint) error {
-	if conf.Index >= uint32(len(tp.Info.Format.Fields)) {
-		return fmt.Errorf("tracepoint %s/%s has %d fields but field %d was requested",
-			tp.Info.Subsys, tp.Info.Event, len(tp.Info.Format.Fields), conf.Index)
-	}
-	field := tp.Info.Format.Fields[conf.Index]
-
-	metaTpIndex := getTracepointMetaValue(conf)
-
-	argIdx := uint32(len(tp.args))
-	tp.args = append(tp.args, genericTracepointArg{
-		CtxOffset:     int(field.Offset),
-		ArgIdx:        argIdx,
-		TpIdx:         int(conf.Index),
-		MetaTp:        metaTpIndex,
-		nopTy:         false,
-		format:        &field,
-		genericTypeId: gt.GenericInvalidType,
-	})
+	arg, err := tp.newArg(int(conf.Index))
+	if err != nil {
+		return err
+	}
+	arg.MetaTp = getTracepointMetaValue(conf)
+	arg.userType = conf.Type
+	tp.args = append(tp.args, arg)
 	return nil
 }
 
 func (out *genericTracepointArg) String() string {
+	if out.btfArg != nil {
+		return fmt.Sprintf("genericTracepointArg{CtxOffset: %d btf: %+v}", out.CtxOffset, out.btfArg)
+	}
 	return fmt.Sprintf("genericTracepointArg{CtxOffset: %d format: %+v}", out.CtxOffset, out.format)
 }
 
@@ -179,6 +222,10 @@ func (out *genericTracepointArg) setGenericTypeId() (int, error) {
 // if such an id cannot be termined, it returns an GenericInvalidType and an error
 func (out *genericTracepointArg) getGenericTypeId() (int, error) {
 
+	if out.btfArg != nil {
+		return out.getBtfGenericTypeId()
+	}
+
 	if out.format == nil {
 		return gt.GenericInvalidType, errors.New("format is nil")
 	}
@@ -225,26 +272,82 @@ func (out *genericTracepointArg) getGenericTypeId() (int, error) {
 	return gt.GenericInvalidType, fmt.Errorf("Unknown type: %T", out.format.Field.Type)
 }
 
+// getBtfGenericTypeId returns the generic type Id of a raw or btf tracepoint
+// argument based on its BTF information, unless the user specified a type.
+func (out *genericTracepointArg) getBtfGenericTypeId() (int, error) {
+	if out.userType != "" {
+		ty := gt.GenericTypeFromString(out.userType)
+		if ty == gt.GenericInvalidType {
+			return ty, fmt.Errorf("unknown type: %s", out.userType)
+		}
+		return ty, nil
+	}
+
+	ty, err := out.btfArg.GenericTypeId()
+	if err != nil {
+		return ty, err
+	}
+	// char * with a metadata argument is treated as a buffer
+	if ty == gt.GenericStringType && out.MetaTp != 0 {
+		return gt.GenericCharBuffer, nil
+	}
+	return ty, nil
+}
+
+// loadTracepointBtfArgs retrieves the arguments of a raw or btf tracepoint from
+// the kernel BTF.
+func loadTracepointBtfArgs(event string) ([]btf.TracepointArgInfo, error) {
+	btfObj, err := btf.NewBTF()
+	if err != nil {
+		return nil, err
+	}
+	defer btfObj.Close()
+	return btf.GetTracepointArgs(btfObj, event)
+}
+
 // createGenericTracepoint creates the genericTracepoint information based on
 // the user-provided configuration
-func createGenericTracepoint(conf *GenericTracepointConf) (*genericTracepoint, error) {
+func createGenericTracepoint(conf *GenericTracepointConf, policyName string) (*genericTracepoint, error) {
 	tp := tracepoint.Tracepoint{
 		Subsys: conf.Subsystem,
 		Event:  conf.Event,
 	}
-
-	if err := tp.LoadFormat(); err != nil {
-		return nil, fmt.Errorf("tracepoint %s/%s not supported: %w", tp.Subsys, tp.Event, err)
+	if err := checkMessage(conf.Message); err != nil {
+		return nil, fmt.Errorf("tracepoint %s/%s: %w", tp.Subsys, tp.Event, err)
 	}
 
 	ret := &genericTracepoint{
-		Info:      &tp,
-		Selectors: conf,
+		Info:       &tp,
+		Selectors:  conf,
+		Type:       conf.Type,
+		policyName: policyName,
+	}
+
+	switch conf.Type {
+	case tracepointTypeClassic:
+		if err := tp.LoadFormat(); err != nil {
+			return nil, fmt.Errorf("tracepoint %s/%s not supported: %w", tp.Subsys, tp.Event, err)
+		}
+	case tracepointTypeRaw, tracepointTypeBtf:
+		if conf.Type == tracepointTypeBtf && !kernels.MinKernelVersion("5.5.0") {
+			return nil, fmt.Errorf("tracepoint %s/%s: btf tracepoints require kernel >= 5.5.0", tp.Subsys, tp.Event)
+		}
+		btfArgs, err := loadTracepointBtfArgs(tp.Event)
+		if err != nil {
+			return nil, fmt.Errorf("tracepoint %s/%s not supported: %w", tp.Subsys, tp.Event, err)
+		}
+		ret.btfArgs = btfArgs
+	default:
+		return nil, fmt.Errorf("tracepoint %s/%s: unknown type %q", tp.Subsys, tp.Event, conf.Type)
 	}
 
 	for i := range conf.Args {
+		if conf.Args[i].MaxData > 0 {
+			return nil, fmt.Errorf("tracepoint %s/%s: maxData is not supported for tracepoint arguments", tp.Subsys, tp.Event)
+		}
 		arg := GenericTracepointConfArg{
 			Index:        conf.Args[i].Index,
+			Type:         conf.Args[i].Type,
 			SizeArgIndex: conf.Args[i].SizeArgIndex,
 			ReturnCopy:   conf.Args[i].ReturnCopy,
 		}
@@ -261,24 +364,13 @@ func createGenericTracepoint(conf *GenericTracepointConf) (*genericTracepoint, e
 			}
 		}
 
-		if tpIdx >= len(ret.Info.Format.Fields) {
-			return nil, fmt.Errorf(
-				"tracepoint %s/%s has %d fields but field %d was requested in a metadata argument",
-				ret.Info.Subsys, ret.Info.Event, len(ret.Info.Format.Fields), tpIdx)
+		arg, err := ret.newArg(tpIdx)
+		if err != nil {
+			return nil, fmt.Errorf("metadata argument: %w", err)
 		}
-		field := ret.Info.Format.Fields[tpIdx]
-		argIdx := uint32(len(ret.args))
-		ret.args = append(ret.args, genericTracepointArg{
-			CtxOffset:     int(field.Offset),
-			ArgIdx:        argIdx,
-			TpIdx:         tpIdx,
-			MetaTp:        0,
-			MetaArg:       0,
-			nopTy:         true,
-			format:        &field,
-			genericTypeId: gt.GenericInvalidType,
-		})
-		return &ret.args[argIdx], nil
+		arg.nopTy = true
+		ret.args = append(ret.args, arg)
+		return &ret.args[arg.ArgIdx], nil
 	}
 
 	for idx := 0; idx < len(ret.args); idx++ {
@@ -299,30 +391,42 @@ func createGenericTracepoint(conf *GenericTracepointConf) (*genericTracepoint, e
 }
 
 // createGenericTracepointSensor will create a sensor that can be loaded based on a generic tracepoint configuration
-func createGenericTracepointSensor(confs []GenericTracepointConf) (*sensors.Sensor, error) {
+func createGenericTracepointSensor(confs []GenericTracepointConf, policyName string) (*sensors.Sensor, error) {
 
 	tracepoints := make([]*genericTracepoint, 0, len(confs))
 	for _, conf := range confs {
-		tp, err := createGenericTracepoint(&conf)
+		tp, err := createGenericTracepoint(&conf, policyName)
 		if err != nil {
 			return nil, err
 		}
 		tracepoints = append(tracepoints, tp)
 	}
 
-	progName := "bpf_generic_tracepoint.o"
-	if kernels.EnableLargeProgs() {
-		progName = "bpf_generic_tracepoint_v53.o"
-	}
-
 	maps := []*sensors.Map{}
 	progs := make([]*sensors.Program, 0, len(tracepoints))
 	for _, tp := range tracepoints {
+		objName := "bpf_generic_tracepoint"
+		label := "tracepoint/generic_tracepoint"
 		attach := fmt.Sprintf("%s/%s", tp.Info.Subsys, tp.Info.Event)
+		switch tp.Type {
+		case tracepointTypeRaw:
+			objName = "bpf_generic_raw_tracepoint"
+			label = "raw_tracepoint/generic_tracepoint"
+			attach = tp.Info.Event
+		case tracepointTypeBtf:
+			objName = "bpf_generic_btf_tracepoint"
+			label = "tp_btf/generic_tracepoint"
+			attach = tp.Info.Event
+		}
+		progName := objName + ".o"
+		if kernels.EnableLargeProgs() {
+			progName = objName + "_v53.o"
+		}
+
 		prog0 := sensors.ProgramBuilder(
 			path.Join(option.Config.HubbleLib, progName),
 			attach,
-			"tracepoint/generic_tracepoint",
+			label,
 			fmt.Sprintf("tracepoint-%s-%s", tp.Info.Subsys, tp.Info.Event),
 			"generic_tracepoint",
 		)
@@ -454,6 +558,20 @@ func LoadGenericTracepointSensor(bpfDir, mapDir string, load *sensors.Program, v
 		return 0, err
 	}
 
+	if tp.isRaw() {
+		return bpf.LoadRawTracepointArgsProgram(
+			version, option.Config.Verbosity,
+			uintptr(btfObj),
+			load.Name,
+			load.Attach,
+			load.Label,
+			filepath.Join(bpfDir, load.PinPath),
+			mapDir,
+			tp.Type == tracepointTypeBtf,
+			kernelSelectors,
+		)
+	}
+
 	return bpf.LoadTracepointArgsProgram(
 		version, option.Config.Verbosity,
 		uintptr(btfObj),
@@ -490,6 +608,8 @@ func handleGenericTracepoint(r *bytes.Reader) ([]observer.Event, error) {
 
 	unix.Subsys = tp.Info.Subsys
 	unix.Event = tp.Info.Event
+	unix.PolicyName = tp.policyName
+	unix.Message = tp.Selectors.Message
 
 	for idx, out := range tp.args {
 
@@ -506,6 +626,14 @@ func handleGenericTracepoint(r *bytes.Reader) ([]observer.Event, error) {
 			}
 			unix.Args = append(unix.Args, val)
 
+		case gt.GenericS64Type:
+			var val int64
+			err := binary.Read(r, binary.LittleEndian, &val)
+			if err != nil {
+				logger.GetLogger().WithError(err).Warnf("Size type error sizeof %d", m.Common.Size)
+			}
+			unix.Args = append(unix.Args, val)
+
 		case gt.GenericSizeType:
 			var val uint64
 
@@ -515,6 +643,22 @@ func handleGenericTracepoint(r *bytes.Reader) ([]observer.Event, error) {
 			}
 			unix.Args = append(unix.Args, val)
 
+		case gt.GenericS32Type:
+			var val int32
+			err := binary.Read(r, binary.LittleEndian, &val)
+			if err != nil {
+				logger.GetLogger().WithError(err).Warnf("s32 type error sizeof %d", m.Common.Size)
+			}
+			unix.Args = append(unix.Args, val)
+
+		case gt.GenericU32Type:
+			var val uint32
+			err := binary.Read(r, binary.LittleEndian, &val)
+			if err != nil {
+				logger.GetLogger().WithError(err).Warnf("u32 type error sizeof %d", m.Common.Size)
+			}
+			unix.Args = append(unix.Args, val)
+
 		case gt.GenericCharBuffer, gt.GenericCharIovec:
 			if arg, err := ReadArgBytes(r, idx); err == nil {
 				unix.Args = append(unix.Args, arg.Value)
@@ -522,6 +666,17 @@ func handleGenericTracepoint(r *bytes.Reader) ([]observer.Event, error) {
 				logger.GetLogger().WithError(err).Warnf("failed to read bytes argument")
 			}
 
+		case gt.GenericIntType, gt.GenericStringType, gt.GenericFilenameType,
+			gt.GenericFileType, gt.GenericFdType, gt.GenericPathType,
+			gt.GenericCredType, gt.GenericSkbType, gt.GenericSockType,
+			gt.GenericBpfAttr, gt.GenericModuleType, gt.GenericLoadInfoType,
+			gt.GenericLinuxBinprm, gt.GenericCapabilityType:
+			// types shared with kprobes (mostly available via raw
+			// and btf tracepoints)
+			if arg := getKprobeArg(r, argPrinters{ty: out.genericTypeId, index: idx}); arg != nil {
+				unix.Args = append(unix.Args, arg)
+			}
+
 		default:
 			logger.GetLogger().Warnf("handleGenericTracepoint: ignoring:  %+v", out)
 		}
@@ -529,12 +684,12 @@ func handleGenericTracepoint(r *bytes.Reader) ([]observer.Event, error) {
 	return []observer.Event{unix}, nil
 }
 
-func (t *observerTracepointSensor) SpecHandler(spec *v1alpha1.TracingPolicySpec) (*sensors.Sensor, error) {
+func (t *observerTracepointSensor) SpecHandler(policyName string, spec *v1alpha1.TracingPolicySpec) (*sensors.Sensor, error) {
 	if len(spec.KProbes) > 0 && len(spec.Tracepoints) > 0 {
 		return nil, errors.New("tracing policies with both kprobes and tracepoints are not currently supported")
 	}
 	if len(spec.Tracepoints) > 0 {
-		return createGenericTracepointSensor(spec.Tracepoints)
+		return createGenericTracepointSensor(spec.Tracepoints, policyName)
 	}
 	return nil, nil
 }
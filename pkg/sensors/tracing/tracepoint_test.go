@@ -89,7 +89,7 @@ func TestGenericTracepointSimple(t *testing.T) {
 	}()
 
 	// create and add sensor
-	sensor, err := createGenericTracepointSensor([]GenericTracepointConf{lseekConf})
+	sensor, err := createGenericTracepointSensor([]GenericTracepointConf{lseekConf}, "")
 	if err != nil {
 		t.Fatalf("failed to create generic tracepoint sensor: %s", err)
 	}
@@ -176,7 +176,7 @@ func doTestGenericTracepointPidFilter(t *testing.T, conf GenericTracepointConf,
 	}()
 
 	// create and add sensor
-	sensor, err := createGenericTracepointSensor([]GenericTracepointConf{conf})
+	sensor, err := createGenericTracepointSensor([]GenericTracepointConf{conf}, "")
 	if err != nil {
 		t.Fatalf("failed to create generic tracepoint sensor: %s", err)
 	}
@@ -375,3 +375,50 @@ func TestGenericTracepointMeta(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestGenericTracepointRawSyscallId(t *testing.T) {
+	lseekId := fmt.Sprintf("%d", unix.SYS_LSEEK)
+
+	tracepointConf := GenericTracepointConf{
+		Subsystem: "raw_syscalls",
+		Event:     "sys_enter",
+		Type:      "raw",
+		Args: []v1alpha1.KProbeArg{
+			v1alpha1.KProbeArg{
+				Index: 1, /* long id */
+			},
+		},
+		Selectors: []v1alpha1.KProbeSelector{
+			{
+				MatchArgs: []v1alpha1.ArgSelector{
+					{
+						Index:    1,
+						Operator: "Equal",
+						Values:   []string{lseekId},
+					},
+				},
+			},
+		},
+	}
+
+	op := func() {
+		fmt.Printf("Calling lseek...\n")
+		unix.Seek(-1, 0, 4444)
+	}
+
+	check := func(event *fgs.ProcessTracepoint) error {
+		if len(event.Args) != 1 {
+			return fmt.Errorf("unexpected number of arguments: %d", len(event.Args))
+		}
+		arg0, ok := event.Args[0].GetArg().(*fgs.KprobeArgument_LongArg)
+		if !ok {
+			return fmt.Errorf("unexpected first arg: %s", event.Args[0])
+		}
+		if arg0.LongArg != int64(unix.SYS_LSEEK) {
+			return fmt.Errorf("unexpected syscall id. got:%d expecting:%d", arg0.LongArg, unix.SYS_LSEEK)
+		}
+		return nil
+	}
+
+	doTestGenericTracepointPidFilter(t, tracepointConf, op, check)
+}
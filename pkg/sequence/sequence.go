@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package sequence detects gaps in streams of events using their sequence
+// numbers.
+package sequence
+
+import (
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+)
+
+// Gap is a range of sequence numbers that are missing from a stream.
+type Gap struct {
+	AgentID string
+	// First and Last are the first and the last missing sequence numbers.
+	First, Last uint64
+}
+
+// Count returns the number of missing events.
+func (g *Gap) Count() uint64 {
+	return g.Last - g.First + 1
+}
+
+// Checker follows the sequence numbers of a stream of events. Events that
+// are delayed, e.g. by aggregation, can arrive out of order: they are not
+// reported as gaps as long as they arrive before a newer event.
+type Checker struct {
+	agentID string
+	last    uint64
+}
+
+// Check returns the gap between the events checked so far and res, if any,
+// and whether the agent restarted since the previous event. Events without a
+// sequence number are ignored.
+func (c *Checker) Check(res *fgs.GetEventsResponse) (gap *Gap, restarted bool) {
+	seq := res.GetSequence()
+	if seq == 0 {
+		return nil, false
+	}
+	if res.GetAgentId() != c.agentID {
+		restarted = c.agentID != ""
+		c.agentID = res.GetAgentId()
+		c.last = seq
+		return nil, restarted
+	}
+	if seq > c.last+1 {
+		gap = &Gap{AgentID: c.agentID, First: c.last + 1, Last: seq - 1}
+	}
+	if seq > c.last {
+		c.last = seq
+	}
+	return gap, false
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package sequence
+
+import (
+	"testing"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/stretchr/testify/assert"
+)
+
+func event(agentID string, seq uint64) *fgs.GetEventsResponse {
+	return &fgs.GetEventsResponse{AgentId: agentID, Sequence: seq}
+}
+
+func TestChecker(t *testing.T) {
+	var c Checker
+
+	gap, restarted := c.Check(event("a", 5))
+	assert.Nil(t, gap, "first event")
+	assert.False(t, restarted)
+
+	gap, _ = c.Check(event("a", 6))
+	assert.Nil(t, gap)
+
+	gap, _ = c.Check(event("a", 10))
+	assert.Equal(t, &Gap{AgentID: "a", First: 7, Last: 9}, gap)
+	assert.Equal(t, uint64(3), gap.Count())
+
+	// late events are not gaps
+	gap, _ = c.Check(event("a", 8))
+	assert.Nil(t, gap)
+	gap, _ = c.Check(event("a", 11))
+	assert.Nil(t, gap)
+
+	// events without a sequence number are ignored
+	gap, restarted = c.Check(&fgs.GetEventsResponse{})
+	assert.Nil(t, gap)
+	assert.False(t, restarted)
+	gap, _ = c.Check(event("a", 12))
+	assert.Nil(t, gap)
+
+	gap, restarted = c.Check(event("b", 1))
+	assert.Nil(t, gap)
+	assert.True(t, restarted)
+	gap, _ = c.Check(event("b", 3))
+	assert.Equal(t, &Gap{AgentID: "b", First: 2, Last: 2}, gap)
+}
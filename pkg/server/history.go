@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+)
+
+type historyEntry struct {
+	received time.Time
+	event    *fgs.GetEventsResponse
+}
+
+// time returns the time at which the event was observed, or the time at
+// which it was added to the history if the event has no timestamp.
+func (e *historyEntry) time() time.Time {
+	if e.event.Time != nil {
+		return e.event.Time.AsTime()
+	}
+	return e.received
+}
+
+// eventHistory is a ring buffer of the most recent events, used to replay
+// them to GetEvents requests. It is registered as a listener, so its memory
+// is bounded by the number of entries: events are evicted when the buffer is
+// full or when they are older than maxAge.
+type eventHistory struct {
+	mu      sync.Mutex
+	entries []historyEntry
+	// start is the index of the oldest entry and count the number of
+	// entries in the buffer
+	start, count int
+	maxAge       time.Duration
+	now          func() time.Time
+}
+
+func newEventHistory(size int, maxAge time.Duration) *eventHistory {
+	return &eventHistory{
+		entries: make([]historyEntry, size),
+		maxAge:  maxAge,
+		now:     time.Now,
+	}
+}
+
+// expire evicts the entries that are older than maxAge. The lock must be
+// held by the caller.
+func (h *eventHistory) expire(now time.Time) {
+	if h.maxAge <= 0 {
+		return
+	}
+	for h.count > 0 {
+		oldest := &h.entries[h.start]
+		if now.Sub(oldest.received) <= h.maxAge {
+			return
+		}
+		*oldest = historyEntry{}
+		h.start = (h.start + 1) % len(h.entries)
+		h.count--
+	}
+}
+
+func (h *eventHistory) Notify(res *fgs.GetEventsResponse) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+	h.expire(now)
+	entry := historyEntry{received: now, event: res}
+	if h.count < len(h.entries) {
+		h.entries[(h.start+h.count)%len(h.entries)] = entry
+		h.count++
+		return
+	}
+	h.entries[h.start] = entry
+	h.start = (h.start + 1) % len(h.entries)
+}
+
+// snapshot returns the entries of the history, from the oldest to the most
+// recent.
+func (h *eventHistory) snapshot() []historyEntry {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.expire(h.now())
+	ret := make([]historyEntry, 0, h.count)
+	for i := 0; i < h.count; i++ {
+		ret = append(ret, h.entries[(h.start+i)%len(h.entries)])
+	}
+	return ret
+}
+
+// replay returns the events of the history that match and that were
+// observed at or after since, keeping at most the lastN most recent ones if
+// lastN is not zero. It also returns the set of all the events that were in
+// the history, so that the caller can skip the ones that it also receives
+// as a listener.
+func (h *eventHistory) replay(since *time.Time, lastN uint32, match func(*fgs.GetEventsResponse) bool) ([]*fgs.GetEventsResponse, map[*fgs.GetEventsResponse]struct{}) {
+	entries := h.snapshot()
+	seen := make(map[*fgs.GetEventsResponse]struct{}, len(entries))
+	var ret []*fgs.GetEventsResponse
+	for i := range entries {
+		entry := &entries[i]
+		seen[entry.event] = struct{}{}
+		if since != nil && entry.time().Before(*since) {
+			continue
+		}
+		if match(entry.event) {
+			ret = append(ret, entry.event)
+		}
+	}
+	if lastN > 0 && len(ret) > int(lastN) {
+		ret = ret[len(ret)-int(lastN):]
+	}
+	return ret, seen
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func matchAll(*fgs.GetEventsResponse) bool {
+	return true
+}
+
+func responseIDs(events []*fgs.GetEventsResponse) []uint64 {
+	var ids []uint64
+	for _, ev := range events {
+		ids = append(ids, ev.GetTest().Arg0)
+	}
+	return ids
+}
+
+func TestEventHistorySize(t *testing.T) {
+	h := newEventHistory(3, 0)
+	for i := uint64(1); i <= 5; i++ {
+		h.Notify(testResponse(i))
+	}
+	events, seen := h.replay(nil, 0, matchAll)
+	assert.Equal(t, []uint64{3, 4, 5}, responseIDs(events))
+	assert.Len(t, seen, 3)
+
+	events, _ = h.replay(nil, 2, matchAll)
+	assert.Equal(t, []uint64{4, 5}, responseIDs(events))
+
+	events, seen = h.replay(nil, 2, func(ev *fgs.GetEventsResponse) bool {
+		return ev.GetTest().Arg0 != 5
+	})
+	assert.Equal(t, []uint64{3, 4}, responseIDs(events))
+	assert.Len(t, seen, 3)
+}
+
+func TestEventHistoryMaxAge(t *testing.T) {
+	now := time.Unix(1000, 0)
+	h := newEventHistory(10, time.Minute)
+	h.now = func() time.Time { return now }
+
+	h.Notify(testResponse(1))
+	now = now.Add(30 * time.Second)
+	h.Notify(testResponse(2))
+	now = now.Add(45 * time.Second)
+	h.Notify(testResponse(3))
+
+	events, _ := h.replay(nil, 0, matchAll)
+	assert.Equal(t, []uint64{2, 3}, responseIDs(events))
+
+	now = now.Add(2 * time.Minute)
+	events, seen := h.replay(nil, 0, matchAll)
+	assert.Empty(t, events)
+	assert.Empty(t, seen)
+}
+
+func TestEventHistorySince(t *testing.T) {
+	now := time.Unix(1000, 0)
+	h := newEventHistory(10, 0)
+	h.now = func() time.Time { return now }
+
+	// events without a timestamp use the time at which they were added
+	h.Notify(testResponse(1))
+	now = now.Add(time.Minute)
+	h.Notify(testResponse(2))
+	// events with a timestamp use it
+	res := testResponse(3)
+	res.Time = timestamppb.New(time.Unix(900, 0))
+	h.Notify(res)
+	h.Notify(testResponse(4))
+
+	since := time.Unix(1030, 0)
+	events, seen := h.replay(&since, 0, matchAll)
+	assert.Equal(t, []uint64{2, 4}, responseIDs(events))
+	assert.Len(t, seen, 4)
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package server
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/isovalent/tetragon-oss/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// defaultListenerBufferSize is the buffer size of listeners for requests
+	// that do not specify one.
+	defaultListenerBufferSize = 100
+	// maxListenerBufferSize bounds the memory used by a single listener.
+	maxListenerBufferSize = 65536
+	// grpcListenerName is the name of the listeners of gRPC clients. They
+	// share it so that clients do not create new metric label values.
+	grpcListenerName = "grpc"
+)
+
+// listenerNames tracks the listeners of each name, so that the metrics of a
+// name are deleted only when its last listener is closed, and so that
+// notifications that concern a single exporter are sent to its listener only.
+var listenerNames = struct {
+	sync.Mutex
+	listeners map[string]map[*getEventsListener]struct{}
+}{listeners: map[string]map[*getEventsListener]struct{}{}}
+
+// getEventsListener buffers the events of a GetEvents request. Notify never
+// blocks, so that a slow client does not stall the other listeners nor the
+// event loop: when the buffer is full, events are dropped according to the
+// drop policy of the request.
+type getEventsListener struct {
+	name   string
+	events chan *fgs.GetEventsResponse
+	policy fgs.ListenerDropPolicy
+
+	// dropped is the number of events dropped since the last call to
+	// takeDropped(), accessed atomically
+	dropped uint64
+	metric  prometheus.Counter
+
+	// overflow is closed when the buffer is full and the drop policy is
+	// LISTENER_DROP_POLICY_DISCONNECT
+	overflow     chan struct{}
+	overflowOnce sync.Once
+}
+
+func newListener(name string, bufferSize uint32, policy fgs.ListenerDropPolicy) (*getEventsListener, error) {
+	if bufferSize == 0 {
+		bufferSize = defaultListenerBufferSize
+	}
+	if bufferSize > maxListenerBufferSize {
+		return nil, fmt.Errorf("buffer size %d is larger than the maximum (%d)", bufferSize, maxListenerBufferSize)
+	}
+	if _, ok := fgs.ListenerDropPolicy_name[int32(policy)]; !ok {
+		return nil, fmt.Errorf("unknown drop policy %d", policy)
+	}
+	l := &getEventsListener{
+		name:     name,
+		events:   make(chan *fgs.GetEventsResponse, bufferSize),
+		policy:   policy,
+		metric:   metrics.ListenerEventsDropped.WithLabelValues(name),
+		overflow: make(chan struct{}),
+	}
+	listenerNames.Lock()
+	if listenerNames.listeners[name] == nil {
+		listenerNames.listeners[name] = map[*getEventsListener]struct{}{}
+	}
+	listenerNames.listeners[name][l] = struct{}{}
+	listenerNames.Unlock()
+	return l, nil
+}
+
+// notifyNamedListeners sends res to the listeners of name only.
+func notifyNamedListeners(name string, res *fgs.GetEventsResponse) {
+	listenerNames.Lock()
+	defer listenerNames.Unlock()
+	for l := range listenerNames.listeners[name] {
+		l.Notify(res)
+	}
+}
+
+func (l *getEventsListener) drop() {
+	atomic.AddUint64(&l.dropped, 1)
+	l.metric.Inc()
+}
+
+// takeDropped returns the number of events dropped since the last call and
+// resets it.
+func (l *getEventsListener) takeDropped() uint64 {
+	return atomic.SwapUint64(&l.dropped, 0)
+}
+
+func (l *getEventsListener) Notify(res *fgs.GetEventsResponse) {
+	select {
+	case l.events <- res:
+		return
+	default:
+	}
+
+	switch l.policy {
+	case fgs.ListenerDropPolicy_LISTENER_DROP_POLICY_DROP_OLDEST:
+		select {
+		case <-l.events:
+			l.drop()
+		default:
+		}
+		select {
+		case l.events <- res:
+		default:
+			l.drop()
+		}
+	case fgs.ListenerDropPolicy_LISTENER_DROP_POLICY_DROP_NEWEST:
+		l.drop()
+	case fgs.ListenerDropPolicy_LISTENER_DROP_POLICY_DISCONNECT:
+		l.drop()
+		l.overflowOnce.Do(func() { close(l.overflow) })
+	}
+}
+
+// close releases the resources of the listener. It must be called after the
+// listener has been removed from the notifier.
+func (l *getEventsListener) close() {
+	listenerNames.Lock()
+	defer listenerNames.Unlock()
+	delete(listenerNames.listeners[l.name], l)
+	if len(listenerNames.listeners[l.name]) > 0 {
+		return
+	}
+	delete(listenerNames.listeners, l.name)
+	metrics.ListenerEventsDropped.DeleteLabelValues(l.name)
+}
+
+func droppedEventsResponse(count uint64) *fgs.GetEventsResponse {
+	return &fgs.GetEventsResponse{
+		Event: &fgs.GetEventsResponse_EventsDropped{
+			EventsDropped: &fgs.EventsDropped{Count: count},
+		},
+	}
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package server
+
+import (
+	"testing"
+
+	"github.com/isovalent/tetragon-oss/api/v1/fgs"
+	"github.com/isovalent/tetragon-oss/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testResponse(id uint64) *fgs.GetEventsResponse {
+	return &fgs.GetEventsResponse{
+		Event: &fgs.GetEventsResponse_Test{Test: &fgs.Test{Arg0: id}},
+	}
+}
+
+func drain(l *getEventsListener) []uint64 {
+	var ids []uint64
+	for {
+		select {
+		case res := <-l.events:
+			ids = append(ids, res.GetTest().Arg0)
+		default:
+			return ids
+		}
+	}
+}
+
+func TestListenerOptions(t *testing.T) {
+	l, err := newListener("test-default", 0, fgs.ListenerDropPolicy_LISTENER_DROP_POLICY_DROP_OLDEST)
+	require.NoError(t, err)
+	defer l.close()
+	assert.Equal(t, defaultListenerBufferSize, cap(l.events))
+
+	_, err = newListener("test-large", maxListenerBufferSize+1, fgs.ListenerDropPolicy_LISTENER_DROP_POLICY_DROP_OLDEST)
+	assert.Error(t, err)
+
+	_, err = newListener("test-policy", 1, fgs.ListenerDropPolicy(42))
+	assert.Error(t, err)
+}
+
+func TestListenerDropOldest(t *testing.T) {
+	l, err := newListener("test-drop-oldest", 2, fgs.ListenerDropPolicy_LISTENER_DROP_POLICY_DROP_OLDEST)
+	require.NoError(t, err)
+	defer l.close()
+
+	for i := uint64(1); i <= 5; i++ {
+		l.Notify(testResponse(i))
+	}
+	assert.Equal(t, []uint64{4, 5}, drain(l))
+	assert.Equal(t, uint64(3), l.takeDropped())
+	assert.Equal(t, uint64(0), l.takeDropped())
+}
+
+func TestListenerDropNewest(t *testing.T) {
+	l, err := newListener("test-drop-newest", 2, fgs.ListenerDropPolicy_LISTENER_DROP_POLICY_DROP_NEWEST)
+	require.NoError(t, err)
+	defer l.close()
+
+	for i := uint64(1); i <= 5; i++ {
+		l.Notify(testResponse(i))
+	}
+	assert.Equal(t, []uint64{1, 2}, drain(l))
+	assert.Equal(t, uint64(3), l.takeDropped())
+}
+
+func TestListenerDisconnect(t *testing.T) {
+	l, err := newListener("test-disconnect", 2, fgs.ListenerDropPolicy_LISTENER_DROP_POLICY_DISCONNECT)
+	require.NoError(t, err)
+	defer l.close()
+
+	l.Notify(testResponse(1))
+	l.Notify(testResponse(2))
+	select {
+	case <-l.overflow:
+		t.Fatal("listener overflowed before its buffer was full")
+	default:
+	}
+
+	l.Notify(testResponse(3))
+	l.Notify(testResponse(4))
+	select {
+	case <-l.overflow:
+	default:
+		t.Fatal("listener did not overflow")
+	}
+	assert.Equal(t, []uint64{1, 2}, drain(l))
+	assert.Equal(t, uint64(2), l.takeDropped())
+}
+
+func TestListenerSharedName(t *testing.T) {
+	l1, err := newListener("test-shared", 1, fgs.ListenerDropPolicy_LISTENER_DROP_POLICY_DROP_NEWEST)
+	require.NoError(t, err)
+	l2, err := newListener("test-shared", 1, fgs.ListenerDropPolicy_LISTENER_DROP_POLICY_DROP_NEWEST)
+	require.NoError(t, err)
+	for _, l := range []*getEventsListener{l1, l2} {
+		l.Notify(testResponse(1))
+		l.Notify(testResponse(2))
+	}
+	metric := metrics.ListenerEventsDropped.WithLabelValues("test-shared")
+	assert.Equal(t, float64(2), testutil.ToFloat64(metric))
+
+	// the metric is kept until the last listener of the name is closed
+	l1.close()
+	assert.Equal(t, float64(2), testutil.ToFloat64(metrics.ListenerEventsDropped.WithLabelValues("test-shared")))
+	l2.close()
+	assert.Equal(t, float64(0), testutil.ToFloat64(metrics.ListenerEventsDropped.WithLabelValues("test-shared")))
+	metrics.ListenerEventsDropped.DeleteLabelValues("test-shared")
+}
+
+func TestNotifyNamedListeners(t *testing.T) {
+	exporter, err := newListener("test-exporter", 2, fgs.ListenerDropPolicy_LISTENER_DROP_POLICY_DROP_NEWEST)
+	require.NoError(t, err)
+	other, err := newListener("test-other", 2, fgs.ListenerDropPolicy_LISTENER_DROP_POLICY_DROP_NEWEST)
+	require.NoError(t, err)
+	defer other.close()
+
+	notifyNamedListeners("test-exporter", testResponse(1))
+	assert.Equal(t, []uint64{1}, drain(exporter))
+	assert.Empty(t, drain(other))
+
+	// closed listeners are not notified anymore
+	exporter.close()
+	notifyNamedListeners("test-exporter", testResponse(2))
+	assert.Empty(t, drain(exporter))
+}
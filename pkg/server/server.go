@@ -6,18 +6,23 @@ package server
 import (
 	"context"
 	"sync"
+	"time"
 
 	v1 "github.com/cilium/hubble/pkg/api/v1"
 	hubbleFilters "github.com/cilium/hubble/pkg/filters"
 	"github.com/isovalent/tetragon-oss/api/v1/fgs"
 	"github.com/isovalent/tetragon-oss/pkg/aggregator"
 	"github.com/isovalent/tetragon-oss/pkg/config"
+	"github.com/isovalent/tetragon-oss/pkg/fieldmask"
 	"github.com/isovalent/tetragon-oss/pkg/filters"
 	"github.com/isovalent/tetragon-oss/pkg/health"
 	"github.com/isovalent/tetragon-oss/pkg/k8s/apis/isovalent.com/v1alpha1"
 	"github.com/isovalent/tetragon-oss/pkg/logger"
+	"github.com/isovalent/tetragon-oss/pkg/option"
 	"github.com/isovalent/tetragon-oss/pkg/sensors"
 	"github.com/isovalent/tetragon-oss/pkg/version"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Listener interface {
@@ -45,33 +50,32 @@ type observer interface {
 type Server struct {
 	notifier notifier
 	observer observer
-}
-
-type getEventsListener struct {
-	events chan *fgs.GetEventsResponse
+	history  *eventHistory
 }
 
 func NewServer(notifier notifier, observer observer) *Server {
-	return &Server{
+	s := &Server{
 		notifier: notifier,
 		observer: observer,
 	}
-}
-
-func newListener() *getEventsListener {
-	return &getEventsListener{
-		events: make(chan *fgs.GetEventsResponse, 100),
+	if option.Config.EventHistorySize > 0 {
+		s.history = newEventHistory(option.Config.EventHistorySize, option.Config.EventHistoryMaxAge)
+		notifier.AddListener(s.history)
 	}
-}
-
-func (l *getEventsListener) Notify(res *fgs.GetEventsResponse) {
-	l.events <- res
+	return s
 }
 
 func (s *Server) NotifyListeners(original interface{}, processed *fgs.GetEventsResponse) {
 	s.notifier.NotifyListener(original, processed)
 }
 
+// NotifyNamedListeners sends processed only to the GetEvents listeners of
+// name, e.g. to the exporter that a notification concerns. It is not recorded
+// in the event history.
+func (s *Server) NotifyNamedListeners(name string, processed *fgs.GetEventsResponse) {
+	notifyNamedListeners(name, processed)
+}
+
 // removeNotifierAndDrain removes the events listener while draining
 // any events that may arrive during removal. This is required in order
 // not to deadlock the process manager.
@@ -91,62 +95,156 @@ func (s *Server) removeNotifierAndDrain(l *getEventsListener) {
 	}
 }
 func (s *Server) GetEvents(request *fgs.GetEventsRequest, server fgs.FineGuidanceSensors_GetEventsServer) error {
-	return s.GetEventsWG(request, server, nil)
+	return s.GetEventsWG(request, server, grpcListenerName, nil)
 }
 
-func (s *Server) GetEventsWG(request *fgs.GetEventsRequest, server fgs.FineGuidanceSensors_GetEventsServer, readyWG *sync.WaitGroup) error {
+// GetEventsWG streams the events of request to server, like GetEvents. name
+// identifies the listener in metrics, e.g. the name of an exporter. readyWG,
+// if not nil, is done once the listener is registered, or when the request is
+// rejected.
+func (s *Server) GetEventsWG(request *fgs.GetEventsRequest, server fgs.FineGuidanceSensors_GetEventsServer, name string, readyWG *sync.WaitGroup) error {
 	logger.GetLogger().WithField("request", request).Debug("Received a GetEvents request")
+	ready := func() {
+		if readyWG != nil {
+			readyWG.Done()
+			readyWG = nil
+		}
+	}
+	defer ready()
 	allowList, err := filters.BuildFilterList(context.Background(), request.AllowList, filters.Filters)
 	if err != nil {
-		return err
+		return status.Errorf(codes.InvalidArgument, "invalid allow list: %v", err)
 	}
 	denyList, err := filters.BuildFilterList(context.Background(), request.DenyList, filters.Filters)
 	if err != nil {
-		return err
+		return status.Errorf(codes.InvalidArgument, "invalid deny list: %v", err)
+	}
+	fieldMasks, err := fieldmask.New(request.FieldMask)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid field mask: %v", err)
+	}
+	replay := request.Since != nil || request.LastN > 0
+	if replay && s.history == nil {
+		return status.Errorf(codes.FailedPrecondition, "event history is disabled")
 	}
 	aggregator, err := aggregator.NewAggregator(server, request.AggregationOptions)
 	if err != nil {
-		return err
+		return status.Errorf(codes.InvalidArgument, "invalid aggregation options: %v", err)
+	}
+
+	l, err := newListener(name, request.BufferSize, request.DropPolicy)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid listener options: %v", err)
 	}
+	defer l.close()
+
 	if aggregator != nil {
-		go aggregator.Start()
+		// The aggregator is stopped when the request ends, and
+		// GetEventsWG waits for it to send the events it still caches.
+		ctx, cancel := context.WithCancel(server.Context())
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			aggregator.Start(ctx)
+		}()
+		defer func() {
+			cancel()
+			<-done
+		}()
+	}
+
+	send := func(event *fgs.GetEventsResponse) error {
+		if aggregator != nil {
+			// Send event to aggregator.
+			select {
+			case aggregator.GetEventChannel() <- event:
+			default:
+				l.drop()
+				logger.GetLogger().
+					WithField("request", request).
+					Warn("Aggregator buffer is full. Consider increasing AggregatorOptions.channel_buffer_size.")
+			}
+			return nil
+		}
+		// No need to aggregate. Directly send out the response.
+		return server.Send(event)
 	}
 
-	l := newListener()
 	s.notifier.AddListener(l)
 	defer s.removeNotifierAndDrain(l)
-	if readyWG != nil {
-		readyWG.Done()
+	ready()
+
+	// The listener is added before reading the history so that no event is
+	// missed. Events that are in both are skipped when they come from the
+	// listener.
+	var replayed map[*fgs.GetEventsResponse]struct{}
+	if replay {
+		var since *time.Time
+		if request.Since != nil {
+			t := request.Since.AsTime()
+			since = &t
+		}
+		var events []*fgs.GetEventsResponse
+		events, replayed = s.history.replay(since, request.LastN, func(event *fgs.GetEventsResponse) bool {
+			return IsNotification(event) || hubbleFilters.Apply(allowList, denyList, &v1.Event{Event: event})
+		})
+		for _, event := range events {
+			if err = send(fieldMasks.Apply(event)); err != nil {
+				return err
+			}
+		}
 	}
+
 	for {
 		select {
 		case event := <-l.events:
-			if !hubbleFilters.Apply(allowList, denyList, &v1.Event{Event: event}) {
-				// Event is filtered out. Nothing to do here. Continue.
-				continue
+			if replayed != nil {
+				if _, ok := replayed[event]; ok {
+					continue
+				}
+				// Events that were not in the history are newer than
+				// all the replayed ones, so there is nothing left to skip.
+				replayed = nil
 			}
 
-			if aggregator != nil {
-				// Send event to aggregator.
-				select {
-				case aggregator.GetEventChannel() <- event:
-				default:
-					logger.GetLogger().
-						WithField("request", request).
-						Warn("Aggregator buffer is full. Consider increasing AggregatorOptions.channel_buffer_size.")
-				}
-			} else {
-				// No need to aggregate. Directly send out the response.
-				if err = server.Send(event); err != nil {
+			// Let the client know that its view is incomplete before
+			// sending newer events.
+			if dropped := l.takeDropped(); dropped > 0 {
+				if err = send(droppedEventsResponse(dropped)); err != nil {
 					return err
 				}
 			}
+
+			if !IsNotification(event) && !hubbleFilters.Apply(allowList, denyList, &v1.Event{Event: event}) {
+				// Event is filtered out. Nothing to do here. Continue.
+				continue
+			}
+			// Events are shared with the other listeners, Apply() does
+			// not modify them.
+			event = fieldMasks.Apply(event)
+
+			if err = send(event); err != nil {
+				return err
+			}
+		case <-l.overflow:
+			return status.Errorf(codes.ResourceExhausted, "events were dropped because the client is too slow")
 		case <-server.Context().Done():
 			return server.Context().Err()
 		}
 	}
 }
 
+// IsNotification returns true for the events that tell clients that their
+// view of the events is incomplete. They are sent to the clients they concern,
+// regardless of their filters.
+func IsNotification(event *fgs.GetEventsResponse) bool {
+	switch event.Event.(type) {
+	case *fgs.GetEventsResponse_EventsDropped, *fgs.GetEventsResponse_LostEvents, *fgs.GetEventsResponse_RateLimitInfo:
+		return true
+	}
+	return false
+}
+
 func (s *Server) GetHealth(ctx context.Context, request *fgs.GetHealthStatusRequest) (*fgs.GetHealthStatusResponse, error) {
 	logger.GetLogger().WithField("request", request).Debug("Received a GetHealth request")
 	return health.GetHealth()
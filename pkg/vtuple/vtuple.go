@@ -118,6 +118,44 @@ func CreateVTupleV4(proto byte, saddr [4]byte, sport uint16, daddr [4]byte, dpor
 	}, nil
 }
 
+type ErrorInvalidAddress struct {
+	addr net.IP
+}
+
+func (e *ErrorInvalidAddress) Error() string {
+	return fmt.Sprintf("invalid address: %s", e.addr)
+}
+
+// CreateVTuple creates a tuple for IPv4 or IPv6 addresses, depending on the
+// family of saddr and daddr.
+func CreateVTuple(proto byte, saddr net.IP, sport uint16, daddr net.IP, dport uint16) (Impl, error) {
+	switch proto {
+	case VT_TCP, VT_UDP:
+	default:
+		return Impl{}, &ErrorUnknownV4Protocol{proto: proto}
+	}
+
+	var l3 uint16
+	switch {
+	case saddr.To4() != nil && daddr.To4() != nil:
+		l3 = VT_IP4
+	case len(saddr) == net.IPv6len && len(daddr) == net.IPv6len:
+		l3 = VT_IP6
+	case len(saddr) != net.IPv4len && len(saddr) != net.IPv6len:
+		return Impl{}, &ErrorInvalidAddress{addr: saddr}
+	default:
+		return Impl{}, &ErrorInvalidAddress{addr: daddr}
+	}
+
+	return Impl{
+		proto:   l3 | uint16(proto),
+		srcAddr: saddr,
+		dstAddr: daddr,
+		srcPort: sport,
+		dstPort: dport,
+	}, nil
+}
+
 func StringRep(vt VTuple) string {
 	proto := "?"
 	if vt.IsTCP() {
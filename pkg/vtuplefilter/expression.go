@@ -0,0 +1,249 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package vtuplefilter
+
+import (
+	"net"
+	"strconv"
+	"strings"
+)
+
+// FromExpression parses a tcpdump-like filter expression, for example:
+//
+//	tcp and dst port 443 and not dst net 10.0.0.0/8
+//
+// Primitives are:
+//   - tcp, udp, ip (or ip4), ip6
+//   - [src|dst] port PORT, and the sport PORT and dport PORT shorthands
+//   - [src|dst] host ADDR, and the saddr ADDR, daddr ADDR and addr ADDR shorthands
+//   - [src|dst] net CIDR
+//
+// Primitives can be combined with and (&&), or (||), not (!) and
+// parentheses. Without parentheses, not binds tighter than and, which binds
+// tighter than or. As in tcpdump, a port, host or net primitive without a
+// direction matches either the source or the destination.
+func FromExpression(s string) (Filter, error) {
+	p := &exprParser{tokens: exprTokens(s)}
+	if len(p.tokens) == 0 {
+		return nil, ParseErrorFmt("empty expression")
+	}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok, ok := p.peek(); ok {
+		return nil, ParseErrorFmt("unexpected %q", tok)
+	}
+	return f, nil
+}
+
+// exprTokens splits an expression into tokens. Parentheses and ! are tokens
+// even if they are not surrounded by spaces.
+func exprTokens(s string) []string {
+	s = strings.NewReplacer("(", " ( ", ")", " ) ", "!", " ! ").Replace(s)
+	return strings.Fields(s)
+}
+
+type exprParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *exprParser) peek() (string, bool) {
+	if p.pos >= len(p.tokens) {
+		return "", false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *exprParser) next() (string, bool) {
+	tok, ok := p.peek()
+	if ok {
+		p.pos++
+	}
+	return tok, ok
+}
+
+func (p *exprParser) accept(toks ...string) bool {
+	tok, ok := p.peek()
+	if !ok {
+		return false
+	}
+	for _, t := range toks {
+		if strings.EqualFold(tok, t) {
+			p.pos++
+			return true
+		}
+	}
+	return false
+}
+
+func (p *exprParser) parseOr() (Filter, error) {
+	f, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	fs := []Filter{f}
+	for p.accept("or", "||") {
+		f, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, f)
+	}
+	if len(fs) == 1 {
+		return fs[0], nil
+	}
+	return CreateOrFilter(fs...), nil
+}
+
+func (p *exprParser) parseAnd() (Filter, error) {
+	f, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	fs := []Filter{f}
+	for p.accept("and", "&&") {
+		f, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, f)
+	}
+	if len(fs) == 1 {
+		return fs[0], nil
+	}
+	return CreateAndFilter(fs...), nil
+}
+
+func (p *exprParser) parseNot() (Filter, error) {
+	if p.accept("not", "!") {
+		f, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return CreateNotFilter(f), nil
+	}
+	if p.accept("(") {
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			return nil, ParseErrorFmt("missing closing parenthesis")
+		}
+		return f, nil
+	}
+	return p.parsePrimitive()
+}
+
+const (
+	dirAny = iota
+	dirSrc
+	dirDst
+)
+
+func (p *exprParser) parsePrimitive() (Filter, error) {
+	tok, ok := p.next()
+	if !ok {
+		return nil, ParseErrorFmt("unexpected end of expression")
+	}
+
+	dir := dirAny
+	switch strings.ToLower(tok) {
+	case "tcp":
+		return &ProtTcpFilter{}, nil
+	case "udp":
+		return &ProtUdpFilter{}, nil
+	case "ip", "ip4":
+		return &ProtIP4Filter{}, nil
+	case "ip6":
+		return &ProtIP6Filter{}, nil
+	case "sport":
+		return p.parsePort(dirSrc)
+	case "dport":
+		return p.parsePort(dirDst)
+	case "saddr":
+		return p.parseHost(dirSrc)
+	case "daddr":
+		return p.parseHost(dirDst)
+	case "addr":
+		return p.parseHost(dirAny)
+	case "src":
+		dir = dirSrc
+	case "dst":
+		dir = dirDst
+	default:
+		p.pos--
+	}
+
+	tok, ok = p.next()
+	if !ok {
+		return nil, ParseErrorFmt("unexpected end of expression")
+	}
+	switch strings.ToLower(tok) {
+	case "port":
+		return p.parsePort(dir)
+	case "host":
+		return p.parseHost(dir)
+	case "net":
+		return p.parseNet(dir)
+	}
+	return nil, ParseErrorFmt("unexpected %q", tok)
+}
+
+func (p *exprParser) parsePort(dir int) (Filter, error) {
+	tok, ok := p.next()
+	if !ok {
+		return nil, ParseErrorFmt("missing port")
+	}
+	port64, err := strconv.ParseUint(tok, 10, 16)
+	if err != nil {
+		return nil, ParseErrorFmt("failed to parse %s as port: %s", tok, err)
+	}
+	port := Port(port64)
+	switch dir {
+	case dirSrc:
+		return CreateSrcPortFilter(port), nil
+	case dirDst:
+		return CreateDstPortFilter(port), nil
+	}
+	return CreateAnyPortFilter(port), nil
+}
+
+func (p *exprParser) parseHost(dir int) (Filter, error) {
+	tok, ok := p.next()
+	if !ok {
+		return nil, ParseErrorFmt("missing address")
+	}
+	ip := net.ParseIP(tok)
+	if ip == nil {
+		return nil, ParseErrorFmt("failed to parse %s as ip", tok)
+	}
+	switch dir {
+	case dirSrc:
+		return CreateSrcAddrFilter(ip), nil
+	case dirDst:
+		return CreateDstAddrFilter(ip), nil
+	}
+	return CreateAnyAddrFilter(ip), nil
+}
+
+func (p *exprParser) parseNet(dir int) (Filter, error) {
+	tok, ok := p.next()
+	if !ok {
+		return nil, ParseErrorFmt("missing network")
+	}
+	_, n, err := net.ParseCIDR(tok)
+	if err != nil {
+		return nil, ParseErrorFmt("failed to parse %s as network: %s", tok, err)
+	}
+	switch dir {
+	case dirSrc:
+		return CreateSrcNetFilter(n), nil
+	case dirDst:
+		return CreateDstNetFilter(n), nil
+	}
+	return CreateAnyNetFilter(n), nil
+}
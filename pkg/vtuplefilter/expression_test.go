@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package vtuplefilter
+
+import (
+	"net"
+	"testing"
+
+	"github.com/isovalent/tetragon-oss/pkg/vtuple"
+)
+
+var (
+	ip8 = [4]byte{8, 8, 8, 8}
+
+	ExprTestCases = []LineTestCase{
+		{
+			line: "tcp and dport 443",
+			tests: []VTRes{
+				{vt: vtuple.CreateTCPv4(ip10, 4242, ip20, 443), res: true},
+				{vt: vtuple.CreateUDPv4(ip10, 4242, ip20, 443), res: false},
+				{vt: vtuple.CreateTCPv4(ip10, 443, ip20, 4242), res: false},
+			},
+		},
+		{
+			line: "udp or port 9999",
+			tests: []VTRes{
+				{vt: vtuple.CreateUDPv4(ip10, 4242, ip20, 443), res: true},
+				{vt: vtuple.CreateTCPv4(ip10, 9999, ip20, 4242), res: true},
+				{vt: vtuple.CreateTCPv4(ip10, 4242, ip20, 443), res: false},
+			},
+		},
+		{
+			line: "tcp && !(dst net 10.0.0.0/8 || dst host 8.8.8.8)",
+			tests: []VTRes{
+				{vt: vtuple.CreateTCPv4(ip10, 4242, ip20, 443), res: false},
+				{vt: vtuple.CreateTCPv4(ip10, 4242, ip8, 443), res: false},
+				{vt: vtuple.CreateTCPv4(ip10, 4242, [4]byte{1, 1, 1, 1}, 443), res: true},
+			},
+		},
+		{
+			line: "src host 10.1.1.10 and not src port 22",
+			tests: []VTRes{
+				{vt: vtuple.CreateTCPv4(ip10, 4242, ip20, 22), res: true},
+				{vt: vtuple.CreateTCPv4(ip10, 22, ip20, 4242), res: false},
+				{vt: vtuple.CreateTCPv4(ip20, 4242, ip10, 22), res: false},
+			},
+		},
+		{
+			line: "addr 10.1.1.20 and (sport 80 or dport 80)",
+			tests: []VTRes{
+				{vt: vtuple.CreateTCPv4(ip10, 80, ip20, 4242), res: true},
+				{vt: vtuple.CreateTCPv4(ip20, 4242, ip10, 80), res: true},
+				{vt: vtuple.CreateTCPv4(ip10, 4242, ip8, 80), res: false},
+			},
+		},
+	}
+)
+
+func TestExpressions(t *testing.T) {
+	for _, tc := range ExprTestCases {
+		filter, err := FromExpression(tc.line)
+		if err != nil {
+			t.Fatalf("failed to parse expression %s: %s", tc.line, err)
+		}
+		for _, vtres := range tc.tests {
+			res := filter.FilterFn(&vtres.vt)
+			if res != vtres.res {
+				t.Errorf("filter:%s tuple:%s expected_result:%t result:%t", tc.line, vtuple.StringRep(&vtres.vt), vtres.res, res)
+			}
+		}
+	}
+}
+
+func TestExpressionsIP6(t *testing.T) {
+	filter, err := FromExpression("ip6 and dst net 2001:db8::/32")
+	if err != nil {
+		t.Fatalf("failed to parse expression: %s", err)
+	}
+	vt, err := vtuple.CreateVTuple(vtuple.VT_TCP, net.ParseIP("2001:db8::1"), 4242, net.ParseIP("2001:db8::2"), 443)
+	if err != nil {
+		t.Fatalf("failed to create tuple: %s", err)
+	}
+	if !filter.FilterFn(&vt) {
+		t.Errorf("expected %s to match", vtuple.StringRep(&vt))
+	}
+	vt, err = vtuple.CreateVTuple(vtuple.VT_TCP, net.ParseIP("10.1.1.10"), 4242, net.ParseIP("10.1.1.20"), 443)
+	if err != nil {
+		t.Fatalf("failed to create tuple: %s", err)
+	}
+	if filter.FilterFn(&vt) {
+		t.Errorf("expected %s not to match", vtuple.StringRep(&vt))
+	}
+}
+
+func TestExpressionErrors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"tcp and",
+		"(tcp or udp",
+		"tcp udp",
+		"dport http",
+		"src host foo",
+		"dst net 10.0.0.0/33",
+		"src tcp",
+	} {
+		if _, err := FromExpression(expr); err == nil {
+			t.Errorf("expected error for expression %q", expr)
+		}
+	}
+}
@@ -56,9 +56,7 @@ func FromLine(s string) (Filter, error) {
 				f = CreateAnyPortFilter(port16)
 			}
 
-		case "saddr":
-		case "daddr":
-		case "addr":
+		case "saddr", "daddr", "addr":
 			ip := net.ParseIP(opts[1])
 			if ip == nil {
 				return nil, ParseErrorFmt("failed to parse %s as ip", opts[1])
@@ -144,6 +142,10 @@ func (op *Not) FilterFn(t vtuple.VTuple) bool {
 	return !op.f.FilterFn(t)
 }
 
+func CreateNotFilter(f Filter) Filter {
+	return &Not{f: f}
+}
+
 // getters/setters (or projections if you are into SQL)
 
 type PortFilter struct {
@@ -209,6 +211,28 @@ func CreateAnyAddrFilter(addr Addr) Filter {
 	return CreateOrFilter(srcF, dstF)
 }
 
+// network filters
+
+func CreateSrcNetFilter(n *net.IPNet) Filter {
+	return &AddrFilter{
+		getAddr: func(t vtuple.VTuple) Addr { return t.SrcAddr() },
+		pred:    func(a Addr) bool { return n.Contains(a) },
+	}
+}
+
+func CreateDstNetFilter(n *net.IPNet) Filter {
+	return &AddrFilter{
+		getAddr: func(t vtuple.VTuple) Addr { return t.DstAddr() },
+		pred:    func(a Addr) bool { return n.Contains(a) },
+	}
+}
+
+func CreateAnyNetFilter(n *net.IPNet) Filter {
+	srcF := CreateSrcNetFilter(n)
+	dstF := CreateDstNetFilter(n)
+	return CreateOrFilter(srcF, dstF)
+}
+
 // protocol filters
 
 type ProtTcpFilter struct{}
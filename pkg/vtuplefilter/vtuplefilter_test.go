@@ -48,6 +48,14 @@ var (
 				{vt: vtuple.CreateTCPv4(ip10, 4242, ip20, 1234), res: false},
 			},
 		},
+		{
+			line: "saddr=10.1.1.10,prot=tcp",
+			tests: []VTRes{
+				{vt: vtuple.CreateTCPv4(ip10, 9999, ip20, 4242), res: true},
+				{vt: vtuple.CreateTCPv4(ip20, 9999, ip10, 4242), res: false},
+				{vt: vtuple.CreateUDPv4(ip10, 9999, ip20, 4242), res: false},
+			},
+		},
 
 		// TODO: more tests
 	}